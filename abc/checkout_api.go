@@ -63,5 +63,5 @@ func CheckoutApi(configuration *configuration.Configuration) *Api {
 }
 
 func buildBaseClient(configuration *configuration.Configuration) client.HttpClient {
-	return client.NewApiClient(configuration, configuration.Environment.BaseUri())
+	return client.NewApiClient(configuration, configuration.BaseUri())
 }
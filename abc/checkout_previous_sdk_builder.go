@@ -1,8 +1,12 @@
 package abc
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+	"net/url"
 
+	"github.com/checkout/checkout-sdk-go/v2/common"
 	"github.com/checkout/checkout-sdk-go/v2/configuration"
 )
 
@@ -20,6 +24,12 @@ func (b *CheckoutPreviousSdkBuilder) WithEnvironment(environment configuration.E
 	return b
 }
 
+func (b *CheckoutPreviousSdkBuilder) WithCustomEnvironment(environment *configuration.CustomEnvironment) *CheckoutPreviousSdkBuilder {
+	b.Environment = environment
+	b.EnvironmentSubdomain = nil
+	return b
+}
+
 func (b *CheckoutPreviousSdkBuilder) WithEnvironmentSubdomain(subdomain string) *CheckoutPreviousSdkBuilder {
 	b.EnvironmentSubdomain = configuration.NewEnvironmentSubdomain(b.Environment, subdomain)
 	return b
@@ -30,11 +40,76 @@ func (b *CheckoutPreviousSdkBuilder) WithHttpClient(client *http.Client) *Checko
 	return b
 }
 
+func (b *CheckoutPreviousSdkBuilder) WithProxy(proxyUrl *url.URL) *CheckoutPreviousSdkBuilder {
+	b.TransportOptions().Proxy = proxyUrl
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithRootCAs(pool *x509.CertPool) *CheckoutPreviousSdkBuilder {
+	b.TransportOptions().RootCAs = pool
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithClientCertificate(certificate tls.Certificate) *CheckoutPreviousSdkBuilder {
+	b.TransportOptions().Certificates = append(b.TransportOptions().Certificates, certificate)
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithMinTLSVersion(version uint16) *CheckoutPreviousSdkBuilder {
+	b.TransportOptions().MinTLSVersion = version
+	return b
+}
+
 func (b *CheckoutPreviousSdkBuilder) WithLogger(logger configuration.StdLogger) *CheckoutPreviousSdkBuilder {
 	b.Logger = logger
 	return b
 }
 
+func (b *CheckoutPreviousSdkBuilder) WithRequestLogger(logger configuration.RequestLogger) *CheckoutPreviousSdkBuilder {
+	b.RequestLogger = logger
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithRedactor(redactor *common.Redactor) *CheckoutPreviousSdkBuilder {
+	b.Redactor = redactor
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithRateLimiter(limiter configuration.RateLimiter) *CheckoutPreviousSdkBuilder {
+	b.RateLimiter = limiter
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithCircuitBreaker(policy *configuration.CircuitBreakerPolicy) *CheckoutPreviousSdkBuilder {
+	b.CircuitBreaker = policy
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithMetrics(metrics configuration.Metrics) *CheckoutPreviousSdkBuilder {
+	b.Metrics = metrics
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithMiddleware(middlewares ...configuration.ClientMiddleware) *CheckoutPreviousSdkBuilder {
+	b.Middlewares = append(b.Middlewares, middlewares...)
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithRetryPolicy(policy *configuration.RetryPolicy) *CheckoutPreviousSdkBuilder {
+	b.RetryPolicy = policy
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithTracer(tracer configuration.Tracer) *CheckoutPreviousSdkBuilder {
+	b.Tracer = tracer
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithIdempotencyKeyGeneration(enabled bool) *CheckoutPreviousSdkBuilder {
+	b.GenerateIdempotencyKeys = enabled
+	return b
+}
+
 func (b *CheckoutPreviousSdkBuilder) WithPublicKey(publicKey string) *CheckoutPreviousSdkBuilder {
 	b.PublicKey = publicKey
 	return b
@@ -45,24 +120,41 @@ func (b *CheckoutPreviousSdkBuilder) WithSecretKey(secretKey string) *CheckoutPr
 	return b
 }
 
+func (b *CheckoutPreviousSdkBuilder) WithSecretSources(secretKey, publicKey configuration.SecretSource) *CheckoutPreviousSdkBuilder {
+	b.SecretKeySource = secretKey
+	b.PublicKeySource = publicKey
+	return b
+}
+
+func (b *CheckoutPreviousSdkBuilder) WithRotatingKeys(credentials *configuration.RotatingKeysSdkCredentials) *CheckoutPreviousSdkBuilder {
+	b.RotatingKeys = credentials
+	return b
+}
+
 func (b *CheckoutPreviousSdkBuilder) Build() (*Api, error) {
-	err := b.ValidateSecretKey(configuration.PreviousSecretKeyPattern)
-	if err != nil {
+	if err := b.ResolveHttpClient(); err != nil {
 		return nil, err
 	}
 
-	err = b.ValidatePublicKey(configuration.PreviousPublicKeyPattern)
+	sdkCredentials, err := b.Credentials(
+		configuration.Previous,
+		configuration.PreviousSecretKeyPattern,
+		configuration.PreviousPublicKeyPattern,
+		func(secretKey, publicKey string) configuration.SdkCredentials {
+			return configuration.NewPreviousKeysSdkCredentials(secretKey, publicKey)
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	sdkCredentials := configuration.NewPreviousKeysSdkCredentials(b.SecretKey, b.PublicKey)
-
 	newConfiguration := configuration.NewConfiguration(sdkCredentials, b.EnableTelemetry, b.Environment, b.HttpClient, b.Logger)
 
 	if b.EnvironmentSubdomain != nil {
 		newConfiguration = configuration.NewConfigurationWithSubdomain(sdkCredentials, b.Environment, b.EnvironmentSubdomain, b.HttpClient, b.Logger)
 	}
 
+	b.ApplyOptions(newConfiguration)
+
 	return CheckoutApi(newConfiguration), nil
 }
@@ -33,8 +33,10 @@ func (c *Client) CreateEntity(request OnboardEntityRequest) (*OnboardEntityRespo
 func (c *Client) CreateEntityWithContext(
 	ctx context.Context,
 	request OnboardEntityRequest,
+	opts ...client.RequestOption,
 ) (*OnboardEntityResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,10 @@ func (c *Client) GetSubEntityMembers(entityId string) (*OnboardSubEntityDetailsR
 func (c *Client) GetSubEntityMembersWithContext(
 	ctx context.Context,
 	entityId string,
+	opts ...client.RequestOption,
 ) (*OnboardSubEntityDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -90,8 +94,10 @@ func (c *Client) ReinviteSubEntityMemberWithContext(
 	entityId string,
 	userId string,
 	request OnboardSubEntityRequest,
+	opts ...client.RequestOption,
 ) (*OnboardSubEntityResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -115,8 +121,9 @@ func (c *Client) GetEntity(entityId string) (*OnboardEntityDetails, error) {
 	return c.GetEntityWithContext(context.Background(), entityId)
 }
 
-func (c *Client) GetEntityWithContext(ctx context.Context, entityId string) (*OnboardEntityDetails, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetEntityWithContext(ctx context.Context, entityId string, opts ...client.RequestOption) (*OnboardEntityDetails, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -138,8 +145,10 @@ func (c *Client) UpdateEntityWithContext(
 	ctx context.Context,
 	entityId string,
 	request OnboardEntityRequest,
+	opts ...client.RequestOption,
 ) (*OnboardEntityResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -166,8 +175,10 @@ func (c *Client) CreatePaymentInstrumentsWithContext(
 	ctx context.Context,
 	entityId string,
 	request PaymentInstrument,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -199,8 +210,10 @@ func (c *Client) CreatePaymentInstrumentWithContext(
 	ctx context.Context,
 	entityId string,
 	request PaymentInstrumentRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -232,8 +245,10 @@ func (c *Client) RetrievePaymentInstrumentDetailsWithContext(
 	ctx context.Context,
 	entityId string,
 	paymentInstrumentId string,
+	opts ...client.RequestOption,
 ) (*PaymentInstrumentDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +278,10 @@ func (c *Client) UpdatePaymentInstrumentDetailsWithContext(
 	ctx context.Context,
 	entityId, instrumentId string,
 	request UpdatePaymentInstrumentRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -295,8 +312,10 @@ func (c *Client) QueryPaymentInstrumentsWithContext(
 	ctx context.Context,
 	entityId string,
 	query PaymentInstrumentsQuery,
+	opts ...client.RequestOption,
 ) (*PaymentInstrumentQueryResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -325,8 +344,10 @@ func (c *Client) RetrievePayoutSchedule(entityId string) (*PayoutSchedule, error
 func (c *Client) RetrievePayoutScheduleWithContext(
 	ctx context.Context,
 	entityId string,
+	opts ...client.RequestOption,
 ) (*PayoutSchedule, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -358,8 +379,10 @@ func (c *Client) UpdatePayoutScheduleWithContext(
 	entityId string,
 	currency common.Currency,
 	updateSchedule CurrencySchedule,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -392,8 +415,10 @@ func (c *Client) CreateReserveRuleWithContext(
 	ctx context.Context,
 	entityId string,
 	request ReserveRuleRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -418,8 +443,9 @@ func (c *Client) GetReserveRules(entityId string) (*ReserveRulesResponse, error)
 	return c.GetReserveRulesWithContext(context.Background(), entityId)
 }
 
-func (c *Client) GetReserveRulesWithContext(ctx context.Context, entityId string) (*ReserveRulesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetReserveRulesWithContext(ctx context.Context, entityId string, opts ...client.RequestOption) (*ReserveRulesResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -445,8 +471,10 @@ func (c *Client) GetReserveRuleDetails(entityId, reserveRuleId string) (*Reserve
 func (c *Client) GetReserveRuleDetailsWithContext(
 	ctx context.Context,
 	entityId, reserveRuleId string,
+	opts ...client.RequestOption,
 ) (*ReserveRuleResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -476,8 +504,10 @@ func (c *Client) UpdateReserveRuleWithContext(
 	ctx context.Context,
 	entityId, reserveRuleId, etag string,
 	request ReserveRuleRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -510,8 +540,39 @@ func (c *Client) SubmitFile(file File) (*common.IdResponse, error) {
 func (c *Client) SubmitFileWithContext(
 	ctx context.Context,
 	file File,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := common.BuildFileUploadRequest(&file)
+	if err != nil {
+		return nil, err
+	}
+
+	var response common.IdResponse
+	err = c.filesClient.UploadWithContext(ctx, common.BuildPath(filesPath), auth, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) SubmitFileStream(file FileStream) (*common.IdResponse, error) {
+	return c.SubmitFileStreamWithContext(context.Background(), file)
+}
+
+func (c *Client) SubmitFileStreamWithContext(
+	ctx context.Context,
+	file FileStream,
+	opts ...client.RequestOption,
+) (*common.IdResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -538,8 +599,40 @@ func (c *Client) UploadFileWithContext(
 	ctx context.Context,
 	entityId string,
 	request File,
+	opts ...client.RequestOption,
+) (*UploadFileResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := common.BuildFileUploadRequest(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response UploadFileResponse
+	err = c.filesClient.UploadWithContext(ctx, common.BuildPath(entitiesPath, entityId, filesPath), auth, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) UploadFileStream(entityId string, request FileStream) (*UploadFileResponse, error) {
+	return c.UploadFileStreamWithContext(context.Background(), entityId, request)
+}
+
+func (c *Client) UploadFileStreamWithContext(
+	ctx context.Context,
+	entityId string,
+	request FileStream,
+	opts ...client.RequestOption,
 ) (*UploadFileResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -562,8 +655,9 @@ func (c *Client) RetrieveFile(entityId, fileId string) (*FileDetailsResponse, er
 	return c.RetrieveFileWithContext(context.Background(), entityId, fileId)
 }
 
-func (c *Client) RetrieveFileWithContext(ctx context.Context, entityId, fileId string) (*FileDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RetrieveFileWithContext(ctx context.Context, entityId, fileId string, opts ...client.RequestOption) (*FileDetailsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -581,8 +675,9 @@ func (c *Client) GetEntityRequirements(entityId string) (*EntityRequirementListR
 	return c.GetEntityRequirementsWithContext(context.Background(), entityId)
 }
 
-func (c *Client) GetEntityRequirementsWithContext(ctx context.Context, entityId string) (*EntityRequirementListResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetEntityRequirementsWithContext(ctx context.Context, entityId string, opts ...client.RequestOption) (*EntityRequirementListResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +695,9 @@ func (c *Client) GetEntityRequirementDetails(entityId, requirementId string) (*E
 	return c.GetEntityRequirementDetailsWithContext(context.Background(), entityId, requirementId)
 }
 
-func (c *Client) GetEntityRequirementDetailsWithContext(ctx context.Context, entityId, requirementId string) (*EntityRequirementDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetEntityRequirementDetailsWithContext(ctx context.Context, entityId, requirementId string, opts ...client.RequestOption) (*EntityRequirementDetailsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -619,8 +715,9 @@ func (c *Client) ResolveEntityRequirement(entityId, requirementId string, reques
 	return c.ResolveEntityRequirementWithContext(context.Background(), entityId, requirementId, request)
 }
 
-func (c *Client) ResolveEntityRequirementWithContext(ctx context.Context, entityId, requirementId string, request EntityRequirementUpdateRequest) (*EntityRequirementUpdateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) ResolveEntityRequirementWithContext(ctx context.Context, entityId, requirementId string, request EntityRequirementUpdateRequest, opts ...client.RequestOption) (*EntityRequirementUpdateResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -2381,3 +2382,152 @@ func TestResolveEntityRequirement(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitFileStream(t *testing.T) {
+	cases := []struct {
+		name             string
+		file             FileStream
+		getAuthorization func(*mock.Mock) mock.Call
+		apiUpload        func(*mock.Mock) mock.Call
+		checker          func(*common.IdResponse, error)
+	}{
+		{
+			name: "when file stream is correct then submit file",
+			file: FileStream{
+				Reader:      strings.NewReader("document"),
+				Filename:    "passport.jpeg",
+				ContentType: "image/jpeg",
+				Purpose:     common.Identification,
+			},
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, "/files", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(4).(*common.IdResponse)
+						*respMapping = common.IdResponse{HttpMetadata: mocks.HttpMetadataStatusOk, Id: "file_123"}
+					})
+			},
+			checker: func(response *common.IdResponse, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, response)
+				assert.Equal(t, "file_123", response.Id)
+			},
+		},
+		{
+			name: "when reader is missing then return error",
+			file: FileStream{
+				Filename: "passport.jpeg",
+				Purpose:  common.Identification,
+			},
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(response *common.IdResponse, err error) {
+				assert.Nil(t, response)
+				assert.NotNil(t, err)
+				assert.Equal(t, "Invalid file reader", err.Error())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			filesClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiUpload(&filesClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient, filesClient)
+
+			tc.checker(client.SubmitFileStream(tc.file))
+		})
+	}
+}
+
+func TestUploadFileStream(t *testing.T) {
+	cases := []struct {
+		name             string
+		entityId         string
+		file             FileStream
+		getAuthorization func(*mock.Mock) mock.Call
+		apiUpload        func(*mock.Mock) mock.Call
+		checker          func(*UploadFileResponse, error)
+	}{
+		{
+			name:     "when file stream is correct then upload file",
+			entityId: "ent_123",
+			file: FileStream{
+				Reader:   strings.NewReader("%PDF-1.4"),
+				Filename: "registration.pdf",
+				Purpose:  common.ProofOfRegistration,
+			},
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, "/entities/ent_123/files", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(4).(*UploadFileResponse)
+						*respMapping = UploadFileResponse{HttpMetadata: mocks.HttpMetadataStatusOk, Id: "file_123"}
+					})
+			},
+			checker: func(response *UploadFileResponse, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, response)
+				assert.Equal(t, "file_123", response.Id)
+			},
+		},
+		{
+			name:     "when credentials invalid then return error",
+			entityId: "ent_123",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(nil, errors.CheckoutAuthorizationError("Invalid authorization type"))
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(response *UploadFileResponse, err error) {
+				assert.Nil(t, response)
+				assert.NotNil(t, err)
+				chkErr := err.(errors.CheckoutAuthorizationError)
+				assert.Equal(t, "Invalid authorization type", chkErr.Error())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			filesClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiUpload(&filesClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient, filesClient)
+
+			tc.checker(client.UploadFileStream(tc.entityId, tc.file))
+		})
+	}
+}
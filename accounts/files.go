@@ -1,6 +1,10 @@
 package accounts
 
-import "github.com/checkout/checkout-sdk-go/v2/common"
+import (
+	"io"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
 
 type File struct {
 	File    string
@@ -18,3 +22,32 @@ func (f *File) GetPurpose() common.Purpose {
 func (f *File) GetFieldName() string {
 	return "path"
 }
+
+type FileStream struct {
+	Reader io.Reader
+	// Filename is the name given to the uploaded file
+	Filename string
+	// ContentType is detected from the first bytes of Reader when empty
+	ContentType string
+	Purpose     common.Purpose
+}
+
+func (f *FileStream) GetFile() string {
+	return f.Filename
+}
+
+func (f *FileStream) GetPurpose() common.Purpose {
+	return f.Purpose
+}
+
+func (f *FileStream) GetFieldName() string {
+	return "path"
+}
+
+func (f *FileStream) GetReader() io.Reader {
+	return f.Reader
+}
+
+func (f *FileStream) GetContentType() string {
+	return f.ContentType
+}
@@ -30,8 +30,9 @@ func (c *Client) CreateDelegatedPaymentToken(request CreateDelegatedPaymentToken
 
 // CreateDelegatedPaymentTokenWithContext is like CreateDelegatedPaymentToken but accepts a
 // context for cancellation and deadline propagation.
-func (c *Client) CreateDelegatedPaymentTokenWithContext(ctx context.Context, request CreateDelegatedPaymentTokenRequest, idempotencyKey *string) (*CreateDelegatedPaymentTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CreateDelegatedPaymentTokenWithContext(ctx context.Context, request CreateDelegatedPaymentTokenRequest, idempotencyKey *string, opts ...client.RequestOption) (*CreateDelegatedPaymentTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -23,8 +23,9 @@ func (c *Client) GetInfo() (*IdealInfo, error) {
 	return c.GetInfoWithContext(context.Background())
 }
 
-func (c *Client) GetInfoWithContext(ctx context.Context) (*IdealInfo, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetInfoWithContext(ctx context.Context, opts ...client.RequestOption) (*IdealInfo, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,9 @@ func (c *Client) GetIssuers() (*IssuerResponse, error) {
 	return c.GetIssuersWithContext(context.Background())
 }
 
-func (c *Client) GetIssuersWithContext(ctx context.Context) (*IssuerResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetIssuersWithContext(ctx context.Context, opts ...client.RequestOption) (*IssuerResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
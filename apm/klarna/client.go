@@ -24,8 +24,9 @@ func (c *Client) CreateCreditSession(request CreditSessionRequest) (*CreditSessi
 	return c.CreateCreditSessionWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateCreditSessionWithContext(ctx context.Context, request CreditSessionRequest) (*CreditSessionResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) CreateCreditSessionWithContext(ctx context.Context, request CreditSessionRequest, opts ...client.RequestOption) (*CreditSessionResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +51,9 @@ func (c *Client) GetCreditSession(sessionId string) (*CreditSession, error) {
 	return c.GetCreditSessionWithContext(context.Background(), sessionId)
 }
 
-func (c *Client) GetCreditSessionWithContext(ctx context.Context, sessionId string) (*CreditSession, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) GetCreditSessionWithContext(ctx context.Context, sessionId string, opts ...client.RequestOption) (*CreditSession, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -74,8 +76,9 @@ func (c *Client) CapturePayment(paymentId string, request OrderCaptureRequest) (
 	return c.CapturePaymentWithContext(context.Background(), paymentId, request)
 }
 
-func (c *Client) CapturePaymentWithContext(ctx context.Context, paymentId string, request OrderCaptureRequest) (*CaptureResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CapturePaymentWithContext(ctx context.Context, paymentId string, request OrderCaptureRequest, opts ...client.RequestOption) (*CaptureResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +103,9 @@ func (c *Client) VoidPayment(paymentId string, request payments.VoidRequest) (*p
 	return c.VoidPaymentWithContext(context.Background(), paymentId, request)
 }
 
-func (c *Client) VoidPaymentWithContext(ctx context.Context, paymentId string, request payments.VoidRequest) (*payments.VoidResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) VoidPaymentWithContext(ctx context.Context, paymentId string, request payments.VoidRequest, opts ...client.RequestOption) (*payments.VoidResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
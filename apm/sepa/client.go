@@ -23,8 +23,9 @@ func (c *Client) GetMandate(mandateId string) (*MandateResponse, error) {
 	return c.GetMandateWithContext(context.Background(), mandateId)
 }
 
-func (c *Client) GetMandateWithContext(ctx context.Context, mandateId string) (*MandateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetMandateWithContext(ctx context.Context, mandateId string, opts ...client.RequestOption) (*MandateResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,9 @@ func (c *Client) CancelMandate(mandateId string) (*SepaResource, error) {
 	return c.CancelMandateWithContext(context.Background(), mandateId)
 }
 
-func (c *Client) CancelMandateWithContext(ctx context.Context, mandateId string) (*SepaResource, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CancelMandateWithContext(ctx context.Context, mandateId string, opts ...client.RequestOption) (*SepaResource, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -68,8 +70,9 @@ func (c *Client) GetMandateViaPpro(mandateId string) (*MandateResponse, error) {
 	return c.GetMandateViaPproWithContext(context.Background(), mandateId)
 }
 
-func (c *Client) GetMandateViaPproWithContext(ctx context.Context, mandateId string) (*MandateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetMandateViaPproWithContext(ctx context.Context, mandateId string, opts ...client.RequestOption) (*MandateResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +90,9 @@ func (c *Client) CancelMandateViaPpro(mandateId string) (*SepaResource, error) {
 	return c.CancelMandateViaPproWithContext(context.Background(), mandateId)
 }
 
-func (c *Client) CancelMandateViaPproWithContext(ctx context.Context, mandateId string) (*SepaResource, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CancelMandateViaPproWithContext(ctx context.Context, mandateId string, opts ...client.RequestOption) (*SepaResource, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -27,8 +27,10 @@ func (c *Client) RetrieveEntityBalancesWithContext(
 	ctx context.Context,
 	entityId string,
 	query QueryFilter,
+	opts ...client.RequestOption,
 ) (*QueryResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,290 @@
+package checkout
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/checkout/checkout-sdk-go/v2/abc"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+	"github.com/checkout/checkout-sdk-go/v2/nas"
+)
+
+// Environment variables read by CheckoutSdkBuilder.FromEnv
+const (
+	EnvAccountType          = "CKO_ACCOUNT_TYPE"
+	EnvEnvironment          = "CKO_ENVIRONMENT"
+	EnvEnvironmentSubdomain = "CKO_ENVIRONMENT_SUBDOMAIN"
+	EnvSecretKey            = "CKO_SECRET_KEY"
+	EnvPublicKey            = "CKO_PUBLIC_KEY"
+	EnvClientId             = "CKO_OAUTH_CLIENT_ID"
+	EnvClientSecret         = "CKO_OAUTH_CLIENT_SECRET"
+	EnvAuthorizationUri     = "CKO_OAUTH_AUTHORIZATION_URI"
+	EnvScopes               = "CKO_OAUTH_SCOPES"
+)
+
+type AccountType string
+
+const (
+	DefaultAccount  AccountType = "default"
+	OAuthAccount    AccountType = "oauth"
+	PreviousAccount AccountType = "previous"
+)
+
+const (
+	sandboxEnvironment    = "sandbox"
+	productionEnvironment = "production"
+)
+
+// SdkConfig holds the settings loaded by FromEnv and FromFile. The account type is inferred from the credentials when
+// it is empty, and so is the environment for static keys.
+type SdkConfig struct {
+	AccountType          AccountType `json:"account_type,omitempty" yaml:"account_type,omitempty"`
+	Environment          string      `json:"environment,omitempty" yaml:"environment,omitempty"`
+	EnvironmentSubdomain string      `json:"environment_subdomain,omitempty" yaml:"environment_subdomain,omitempty"`
+	SecretKey            string      `json:"secret_key,omitempty" yaml:"secret_key,omitempty"`
+	PublicKey            string      `json:"public_key,omitempty" yaml:"public_key,omitempty"`
+	ClientId             string      `json:"client_id,omitempty" yaml:"client_id,omitempty"`
+	ClientSecret         string      `json:"client_secret,omitempty" yaml:"client_secret,omitempty"`
+	AuthorizationUri     string      `json:"authorization_uri,omitempty" yaml:"authorization_uri,omitempty"`
+	Scopes               []string    `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+}
+
+// ConfiguredSdkBuilder builds the SDK from an SdkConfig. Errors found while loading or validating the settings are
+// returned by Build and BuildPrevious.
+type ConfiguredSdkBuilder struct {
+	Config  SdkConfig
+	err     error
+	options []func(*configuration.SdkBuilder)
+}
+
+// FromEnv reads the settings of the SDK from the CKO_* environment variables. CKO_OAUTH_SCOPES lists the scopes
+// separated by spaces or commas.
+func (b *CheckoutSdkBuilder) FromEnv() *ConfiguredSdkBuilder {
+	return &ConfiguredSdkBuilder{Config: configFromEnv(os.LookupEnv)}
+}
+
+// FromFile reads the settings of the SDK from a JSON file, when its extension is ".json", or from a YAML file.
+func (b *CheckoutSdkBuilder) FromFile(path string) *ConfiguredSdkBuilder {
+	config, err := configFromFile(path)
+	return &ConfiguredSdkBuilder{Config: config, err: err}
+}
+
+func (b *CheckoutSdkBuilder) FromConfig(config SdkConfig) *ConfiguredSdkBuilder {
+	return &ConfiguredSdkBuilder{Config: config}
+}
+
+// WithOptions sets the client settings that are not part of the configuration, such as the HTTP client or the retry
+// policy, on the builder selected for the account type.
+func (b *ConfiguredSdkBuilder) WithOptions(option func(builder *configuration.SdkBuilder)) *ConfiguredSdkBuilder {
+	b.options = append(b.options, option)
+	return b
+}
+
+// Build returns the API of a default or OAuth account.
+func (b *ConfiguredSdkBuilder) Build() (*nas.Api, error) {
+	accountType, environment, err := b.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	switch accountType {
+	case DefaultAccount:
+		builder := &nas.CheckoutDefaultSdkBuilder{}
+		b.apply(&builder.SdkBuilder, environment)
+		return builder.
+			WithSecretKey(b.Config.SecretKey).
+			WithPublicKey(b.Config.PublicKey).
+			Build()
+	case OAuthAccount:
+		builder := &nas.CheckoutOAuthSdkBuilder{}
+		b.apply(&builder.SdkBuilder, environment)
+		return builder.
+			WithClientCredentials(b.Config.ClientId, b.Config.ClientSecret).
+			WithAuthorizationUri(b.Config.AuthorizationUri).
+			WithScopes(b.Config.Scopes).
+			Build()
+	default:
+		return nil, errors.CheckoutArgumentError("Previous account keys require BuildPrevious")
+	}
+}
+
+// BuildPrevious returns the API of a previous account.
+func (b *ConfiguredSdkBuilder) BuildPrevious() (*abc.Api, error) {
+	accountType, environment, err := b.validate()
+	if err != nil {
+		return nil, err
+	}
+	if accountType != PreviousAccount {
+		return nil, errors.CheckoutArgumentError("Default and OAuth accounts require Build")
+	}
+
+	builder := &abc.CheckoutPreviousSdkBuilder{}
+	b.apply(&builder.SdkBuilder, environment)
+	return builder.
+		WithSecretKey(b.Config.SecretKey).
+		WithPublicKey(b.Config.PublicKey).
+		Build()
+}
+
+func (b *ConfiguredSdkBuilder) apply(builder *configuration.SdkBuilder, environment configuration.Environment) {
+	builder.Environment = environment
+	if b.Config.EnvironmentSubdomain != "" {
+		builder.EnvironmentSubdomain = configuration.NewEnvironmentSubdomain(environment, b.Config.EnvironmentSubdomain)
+	}
+	for _, option := range b.options {
+		option(builder)
+	}
+}
+
+// validate resolves the account type and the environment of the configuration, and rejects the credentials that do
+// not match them.
+func (b *ConfiguredSdkBuilder) validate() (AccountType, configuration.Environment, error) {
+	if b.err != nil {
+		return "", nil, b.err
+	}
+	config := b.Config
+
+	accountType, err := config.accountType()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var secretKeyPattern, publicKeyPattern, sandboxPrefix string
+	switch accountType {
+	case OAuthAccount:
+		if config.SecretKey != "" || config.PublicKey != "" {
+			return "", nil, errors.CheckoutArgumentError("OAuth client credentials cannot be combined with static keys")
+		}
+		if config.ClientId == "" || config.ClientSecret == "" {
+			return "", nil, errors.CheckoutArgumentError("Invalid OAuth 'client_id' or 'client_secret'")
+		}
+		if config.Environment == "" {
+			return "", nil, errors.CheckoutArgumentError("The environment is required for OAuth credentials")
+		}
+		environment, err := parseEnvironment(config.Environment)
+		return accountType, environment, err
+	case DefaultAccount:
+		secretKeyPattern, publicKeyPattern, sandboxPrefix = configuration.DefaultSecretKeyPattern, configuration.DefaultPublicKeyPattern, "sbox_"
+	default:
+		secretKeyPattern, publicKeyPattern, sandboxPrefix = configuration.PreviousSecretKeyPattern, configuration.PreviousPublicKeyPattern, "test_"
+	}
+
+	if config.ClientId != "" || config.ClientSecret != "" {
+		return "", nil, errors.CheckoutArgumentError("Static keys cannot be combined with OAuth client credentials")
+	}
+	if !regexp.MustCompile(secretKeyPattern).MatchString(config.SecretKey) {
+		return "", nil, errors.CheckoutArgumentError("Invalid secret key")
+	}
+	if config.PublicKey != "" && !regexp.MustCompile(publicKeyPattern).MatchString(config.PublicKey) {
+		return "", nil, errors.CheckoutArgumentError("Invalid public key")
+	}
+
+	sandboxKeys := strings.HasPrefix(config.SecretKey, "sk_"+sandboxPrefix)
+	if config.PublicKey != "" && strings.HasPrefix(config.PublicKey, "pk_"+sandboxPrefix) != sandboxKeys {
+		return "", nil, errors.CheckoutArgumentError("The secret key and the public key belong to different environments")
+	}
+
+	name := config.Environment
+	if name == "" {
+		name = productionEnvironment
+		if sandboxKeys {
+			name = sandboxEnvironment
+		}
+	}
+	environment, err := parseEnvironment(name)
+	if err != nil {
+		return "", nil, err
+	}
+	if environment.IsSandbox() != sandboxKeys {
+		return "", nil, errors.CheckoutArgumentError("The keys do not belong to the " + strings.ToLower(name) + " environment")
+	}
+
+	return accountType, environment, nil
+}
+
+func (c SdkConfig) accountType() (AccountType, error) {
+	switch AccountType(strings.ToLower(string(c.AccountType))) {
+	case DefaultAccount:
+		return DefaultAccount, nil
+	case OAuthAccount:
+		return OAuthAccount, nil
+	case PreviousAccount:
+		return PreviousAccount, nil
+	case "":
+	default:
+		return "", errors.CheckoutArgumentError("Invalid account type " + string(c.AccountType))
+	}
+
+	switch {
+	case c.ClientId != "" || c.ClientSecret != "":
+		return OAuthAccount, nil
+	case regexp.MustCompile(configuration.PreviousSecretKeyPattern).MatchString(c.SecretKey):
+		return PreviousAccount, nil
+	default:
+		return DefaultAccount, nil
+	}
+}
+
+func parseEnvironment(name string) (configuration.Environment, error) {
+	switch strings.ToLower(name) {
+	case sandboxEnvironment:
+		return configuration.Sandbox(), nil
+	case productionEnvironment:
+		return configuration.Production(), nil
+	default:
+		return nil, errors.CheckoutArgumentError("Invalid environment " + name)
+	}
+}
+
+func configFromEnv(lookup func(string) (string, bool)) SdkConfig {
+	get := func(key string) string {
+		value, _ := lookup(key)
+		return strings.TrimSpace(value)
+	}
+
+	return SdkConfig{
+		AccountType:          AccountType(get(EnvAccountType)),
+		Environment:          get(EnvEnvironment),
+		EnvironmentSubdomain: get(EnvEnvironmentSubdomain),
+		SecretKey:            get(EnvSecretKey),
+		PublicKey:            get(EnvPublicKey),
+		ClientId:             get(EnvClientId),
+		ClientSecret:         get(EnvClientSecret),
+		AuthorizationUri:     get(EnvAuthorizationUri),
+		Scopes: strings.FieldsFunc(get(EnvScopes), func(r rune) bool {
+			return r == ',' || r == ' '
+		}),
+	}
+}
+
+func configFromFile(path string) (SdkConfig, error) {
+	var config SdkConfig
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&config)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&config)
+	}
+	if err != nil {
+		return config, errors.CheckoutArgumentError("Invalid configuration file " + path + ": " + err.Error())
+	}
+
+	return config, nil
+}
@@ -0,0 +1,134 @@
+package checkout
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+const (
+	sandboxSecretKey    = "sk_sbox_m73dzbpy7cf3gfd46xr4yj5xo4e"
+	sandboxPublicKey    = "pk_sbox_pkhpdtvmkgf7hdgpwnbhw7r2uic"
+	productionSecretKey = "sk_m73dzbpy7cf3gfd46xr4yj5xo4e"
+	previousSecretKey   = "sk_test_fde517a8-3f01-41ef-b4bd-4282384b0a64"
+	previousPublicKey   = "pk_test_fe70ff27-fab2-47c0-bd3c-2ed2f2a1ed1d"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	dir, err := ioutil.TempDir("", "checkout-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, name)
+	if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	env := map[string]string{
+		EnvEnvironment:  "Sandbox",
+		EnvClientId:     "client_id",
+		EnvClientSecret: " client_secret ",
+		EnvScopes:       "gateway, disputes:view vault",
+	}
+	config := configFromEnv(func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	})
+
+	assert.Equal(t, SdkConfig{
+		Environment:  "Sandbox",
+		ClientId:     "client_id",
+		ClientSecret: "client_secret",
+		Scopes:       []string{configuration.Gateway, configuration.DisputesView, configuration.Vault},
+	}, config)
+}
+
+func TestFromFile(t *testing.T) {
+	yamlPath := writeConfigFile(t, "checkout.yaml", `
+account_type: oauth
+environment: production
+client_id: client_id
+client_secret: client_secret
+scopes:
+  - gateway
+  - vault
+`)
+	jsonPath := writeConfigFile(t, "checkout.json", `{"secret_key": "`+sandboxSecretKey+`", "environment_subdomain": "123dmain"}`)
+
+	config := Builder().FromFile(yamlPath).Config
+	assert.Equal(t, OAuthAccount, config.AccountType)
+	assert.Equal(t, "production", config.Environment)
+	assert.Equal(t, []string{configuration.Gateway, configuration.Vault}, config.Scopes)
+
+	config = Builder().FromFile(jsonPath).Config
+	assert.Equal(t, sandboxSecretKey, config.SecretKey)
+	assert.Equal(t, "123dmain", config.EnvironmentSubdomain)
+}
+
+func TestFromFile_Invalid(t *testing.T) {
+	_, err := Builder().FromFile(writeConfigFile(t, "checkout.yml", "secret: value\n")).Build()
+	assert.Contains(t, err.Error(), "Invalid configuration file")
+
+	_, err = Builder().FromFile(filepath.Join(os.TempDir(), "missing-checkout.json")).Build()
+	assert.NotNil(t, err)
+}
+
+func TestFromConfig_BuildsDefaultAccount(t *testing.T) {
+	var configured bool
+	api, err := Builder().
+		FromConfig(SdkConfig{SecretKey: sandboxSecretKey, PublicKey: sandboxPublicKey, EnvironmentSubdomain: "123dmain"}).
+		WithOptions(func(builder *configuration.SdkBuilder) {
+			configured = builder.Environment.IsSandbox()
+		}).
+		Build()
+
+	assert.Nil(t, err)
+	assert.NotNil(t, api)
+	assert.True(t, configured)
+}
+
+func TestFromConfig_BuildsPreviousAccount(t *testing.T) {
+	builder := Builder().FromConfig(SdkConfig{SecretKey: previousSecretKey, PublicKey: previousPublicKey})
+
+	api, err := builder.BuildPrevious()
+	assert.Nil(t, err)
+	assert.NotNil(t, api)
+
+	_, err = builder.Build()
+	assert.EqualError(t, err, "Previous account keys require BuildPrevious")
+}
+
+func TestFromConfig_RejectsMismatches(t *testing.T) {
+	cases := []struct {
+		name   string
+		config SdkConfig
+		err    string
+	}{
+		{"sandbox keys in production", SdkConfig{SecretKey: sandboxSecretKey, Environment: "production"}, "The keys do not belong to the production environment"},
+		{"production keys in sandbox", SdkConfig{SecretKey: productionSecretKey, Environment: "sandbox"}, "The keys do not belong to the sandbox environment"},
+		{"keys of different environments", SdkConfig{SecretKey: productionSecretKey, PublicKey: sandboxPublicKey}, "The secret key and the public key belong to different environments"},
+		{"previous keys for a default account", SdkConfig{AccountType: DefaultAccount, SecretKey: previousSecretKey}, "Invalid secret key"},
+		{"invalid public key", SdkConfig{SecretKey: sandboxSecretKey, PublicKey: previousPublicKey}, "Invalid public key"},
+		{"keys and client credentials", SdkConfig{AccountType: OAuthAccount, ClientId: "id", ClientSecret: "secret", SecretKey: sandboxSecretKey}, "OAuth client credentials cannot be combined with static keys"},
+		{"OAuth without environment", SdkConfig{ClientId: "id", ClientSecret: "secret"}, "The environment is required for OAuth credentials"},
+		{"unknown environment", SdkConfig{SecretKey: sandboxSecretKey, Environment: "staging"}, "Invalid environment staging"},
+		{"unknown account type", SdkConfig{AccountType: "platform", SecretKey: sandboxSecretKey}, "Invalid account type platform"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Builder().FromConfig(tc.config).Build()
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	sdkerrors "github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type callOutcome int
+
+const (
+	outcomeSuccess callOutcome = iota
+	outcomeFailure
+	outcomeIgnored
+)
+
+// circuitBreaker stops calling a host after consecutive 5xx responses or transport errors. Once the cool-down has
+// elapsed a single probe call is let through at a time, and the circuit closes after enough successful probes.
+type circuitBreaker struct {
+	policy *configuration.CircuitBreakerPolicy
+	host   string
+	now    func() time.Time
+
+	mutex     sync.Mutex
+	state     circuitState
+	failures  int
+	successes int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(policy *configuration.CircuitBreakerPolicy, baseUri string) *circuitBreaker {
+	host := baseUri
+	if u, err := url.Parse(baseUri); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return &circuitBreaker{policy: policy, host: host, now: time.Now}
+}
+
+func (b *circuitBreaker) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	probe, err := b.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next(call)
+	b.record(probe, classifyOutcome(resp, err))
+	return resp, err
+}
+
+func (b *circuitBreaker) allow() (bool, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case circuitClosed:
+		return false, nil
+	case circuitOpen:
+		if b.now().Before(b.openUntil) {
+			return false, b.openError()
+		}
+		b.state = circuitHalfOpen
+		b.successes = 0
+	}
+
+	if b.probing {
+		return false, b.openError()
+	}
+	b.probing = true
+	return true, nil
+}
+
+func (b *circuitBreaker) record(probe bool, outcome callOutcome) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if probe {
+		b.probing = false
+		switch outcome {
+		case outcomeFailure:
+			b.open()
+		case outcomeSuccess:
+			b.successes++
+			if b.successes >= b.policy.SuccessThreshold {
+				b.state = circuitClosed
+				b.failures = 0
+			}
+		}
+		return
+	}
+
+	if b.state != circuitClosed {
+		return
+	}
+
+	switch outcome {
+	case outcomeFailure:
+		b.failures++
+		if b.failures >= b.policy.FailureThreshold {
+			b.open()
+		}
+	case outcomeSuccess:
+		b.failures = 0
+	}
+}
+
+func (b *circuitBreaker) open() {
+	b.state = circuitOpen
+	b.openUntil = b.now().Add(b.policy.CoolDown)
+}
+
+func (b *circuitBreaker) openError() error {
+	return sdkerrors.CircuitOpenError{Host: b.host, OpenUntil: b.openUntil}
+}
+
+// classifyOutcome counts 5xx responses and transport errors as failures. Calls cancelled by the caller say nothing
+// about the health of the API and are ignored.
+func classifyOutcome(resp *http.Response, err error) callOutcome {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return outcomeIgnored
+		}
+		return outcomeFailure
+	}
+	if resp != nil && resp.StatusCode >= http.StatusInternalServerError {
+		return outcomeFailure
+	}
+	return outcomeSuccess
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+func newBreakerClient(baseURL string) (*ApiClient, *time.Time) {
+	now := time.Unix(1700000000, 0)
+	client := newTestClient(baseURL)
+	client.breaker = newCircuitBreaker(&configuration.CircuitBreakerPolicy{
+		FailureThreshold: 2,
+		CoolDown:         time.Minute,
+		SuccessThreshold: 1,
+	}, baseURL)
+	client.breaker.now = func() time.Time { return now }
+	return client, &now
+}
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusInternalServerError, &calls, nil)
+	defer server.Close()
+
+	client, _ := newBreakerClient(server.URL)
+	var resp common.IdResponse
+
+	assert.IsType(t, errors.CheckoutAPIError{}, client.Get("/test", testAuth(), &resp))
+	assert.IsType(t, errors.CheckoutAPIError{}, client.Get("/test", testAuth(), &resp))
+
+	err := client.Get("/test", testAuth(), &resp)
+	assert.IsType(t, errors.CircuitOpenError{}, err)
+	assert.Equal(t, int32(2), calls)
+	assert.Equal(t, server.Listener.Addr().String(), err.(errors.CircuitOpenError).Host)
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1)%2 == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client, _ := newBreakerClient(server.URL)
+	var resp common.IdResponse
+	for i := 0; i < 6; i++ {
+		_ = client.Get("/test", testAuth(), &resp)
+	}
+
+	assert.Equal(t, int32(6), calls)
+}
+
+func TestCircuitBreaker_IgnoresClientErrors(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusNotFound, &calls, nil)
+	defer server.Close()
+
+	client, _ := newBreakerClient(server.URL)
+	var resp common.IdResponse
+	for i := 0; i < 4; i++ {
+		assert.IsType(t, errors.CheckoutAPIError{}, client.Get("/test", testAuth(), &resp))
+	}
+
+	assert.Equal(t, int32(4), calls)
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	var calls int32
+	server := failingServer(3, http.StatusServiceUnavailable, &calls, nil)
+	defer server.Close()
+
+	client, now := newBreakerClient(server.URL)
+	var resp common.IdResponse
+	_ = client.Get("/test", testAuth(), &resp)
+	_ = client.Get("/test", testAuth(), &resp)
+
+	*now = now.Add(time.Minute)
+	assert.IsType(t, errors.CheckoutAPIError{}, client.Get("/test", testAuth(), &resp), "failed probe")
+	assert.IsType(t, errors.CircuitOpenError{}, client.Get("/test", testAuth(), &resp), "reopened")
+	assert.Equal(t, int32(3), calls)
+
+	*now = now.Add(time.Minute)
+	assert.Nil(t, client.Get("/test", testAuth(), &resp), "successful probe")
+	assert.Nil(t, client.Get("/test", testAuth(), &resp), "closed")
+	assert.Equal(t, int32(5), calls)
+}
+
+func TestCircuitBreaker_SingleProbeAtATime(t *testing.T) {
+	breaker := newCircuitBreaker(&configuration.CircuitBreakerPolicy{FailureThreshold: 1, SuccessThreshold: 1}, "https://api.checkout.com")
+	breaker.record(false, outcomeFailure)
+
+	probe, err := breaker.allow()
+	assert.True(t, probe)
+	assert.Nil(t, err)
+
+	_, err = breaker.allow()
+	assert.Equal(t, "api.checkout.com", err.(errors.CircuitOpenError).Host)
+
+	breaker.record(true, outcomeIgnored)
+	probe, err = breaker.allow()
+	assert.True(t, probe)
+	assert.Nil(t, err)
+}
+
+func TestCircuitBreaker_IgnoresCancelledCalls(t *testing.T) {
+	assert.Equal(t, outcomeIgnored, classifyOutcome(nil, context.Canceled))
+	assert.Equal(t, outcomeFailure, classifyOutcome(nil, context.DeadlineExceeded))
+	assert.Equal(t, outcomeSuccess, classifyOutcome(&http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+}
+
+func TestCircuitBreaker_StopsRetries(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusServiceUnavailable, &calls, nil)
+	defer server.Close()
+
+	client := newRetryClient(server.URL)
+	client.breaker = newCircuitBreaker(&configuration.CircuitBreakerPolicy{FailureThreshold: 2, CoolDown: time.Minute}, server.URL)
+
+	var resp common.IdResponse
+	err := client.Get("/test", testAuth(), &resp)
+
+	assert.IsType(t, errors.CircuitOpenError{}, err)
+	assert.Equal(t, int32(2), calls)
+}
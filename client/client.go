@@ -3,7 +3,6 @@ package client
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -42,21 +41,49 @@ type ApiClient struct {
 	EnableTelemetry     bool
 	RequestMetricsQueue common.TelemetryQueue
 	Log                 configuration.StdLogger
+	RetryPolicy         *configuration.RetryPolicy
+	// GenerateIdempotencyKeys adds a random idempotency key to every POST and PUT request sent without one
+	GenerateIdempotencyKeys bool
+	Middlewares             []configuration.ClientMiddleware
+	Tracer                  configuration.Tracer
+	Metrics                 configuration.Metrics
+	RequestLogger           configuration.RequestLogger
+	Redactor                *common.Redactor
+	RateLimiter             configuration.RateLimiter
+
+	breaker *circuitBreaker
 }
 
 const (
 	CkoRequestId       = "cko-request-id"
 	CkoVersion         = "cko-version"
 	CkoTelemetryHeader = "cko-sdk-telemetry"
+	CkoIdempotencyKey  = "Cko-Idempotency-Key"
 )
 
 func NewApiClient(configuration *configuration.Configuration, baseUri string) *ApiClient {
+	var breaker *circuitBreaker
+	if configuration.CircuitBreaker != nil {
+		breaker = newCircuitBreaker(configuration.CircuitBreaker, baseUri)
+	}
+
 	return &ApiClient{
 		HttpClient:          configuration.HttpClient,
 		BaseUri:             baseUri,
 		EnableTelemetry:     configuration.EnableTelemetry,
 		RequestMetricsQueue: *common.NewTelemetryQueue(),
 		Log:                 configuration.Logger,
+		RetryPolicy:         configuration.RetryPolicy,
+
+		GenerateIdempotencyKeys: configuration.GenerateIdempotencyKeys,
+		Middlewares:             configuration.Middlewares,
+		Tracer:                  configuration.Tracer,
+		Metrics:                 configuration.Metrics,
+		RequestLogger:           configuration.RequestLogger,
+		Redactor:                configuration.Redactor,
+		RateLimiter:             configuration.RateLimiter,
+
+		breaker: breaker,
 	}
 }
 
@@ -130,15 +157,22 @@ func (a *ApiClient) invoke(
 		return err
 	}
 
+	if key := RequestOptionsFromContext(ctx).IdempotencyKey; key != nil {
+		idempotencyKey = key
+	}
+
+	if idempotencyKey == nil && a.GenerateIdempotencyKeys && (method == http.MethodPost || method == http.MethodPut) {
+		generatedKey := uuid.New().String()
+		idempotencyKey = &generatedKey
+		ctx = configuration.ContextWithGeneratedIdempotencyKey(ctx)
+	}
+
 	req, err := a.buildRequest(ctx, method, path, authorization, "application/json", body, idempotencyKey, request)
 	if err != nil {
 		return err
 	}
 
-	a.Log.Printf("%s: %s", method, path)
-
-	return a.doRequest(ctx, req, responseMapping)
-
+	return a.doRequest(ctx, newCall(method, path, authorization, req, request), responseMapping)
 }
 
 func applyRequestHeaders(request interface{}, headers http.Header) {
@@ -199,13 +233,18 @@ func (a *ApiClient) submit(
 	request *common.FileUploadRequest,
 	responseMapping interface{},
 ) error {
-	req, err := a.buildRequest(ctx, http.MethodPost, path, authorization, request.W.FormDataContentType(), request.B, nil, request)
+	var body io.Reader = request.B
+	if request.Body != nil {
+		body = request.Body
+	}
+
+	idempotencyKey := RequestOptionsFromContext(ctx).IdempotencyKey
+	req, err := a.buildRequest(ctx, http.MethodPost, path, authorization, request.W.FormDataContentType(), body, idempotencyKey, request)
 	if err != nil {
 		return err
 	}
 
-	a.Log.Printf("post: %s", path)
-	return a.doRequest(ctx, req, responseMapping)
+	return a.doRequest(ctx, newCall(http.MethodPost, path, authorization, req, request), responseMapping)
 }
 
 func (a *ApiClient) submitForm(
@@ -216,13 +255,13 @@ func (a *ApiClient) submitForm(
 	responseMapping interface{},
 ) error {
 	body := bytes.NewBufferString(formData.Encode())
-	req, err := a.buildRequest(ctx, http.MethodPost, path, authorization, "application/x-www-form-urlencoded", body, nil, nil)
+	idempotencyKey := RequestOptionsFromContext(ctx).IdempotencyKey
+	req, err := a.buildRequest(ctx, http.MethodPost, path, authorization, "application/x-www-form-urlencoded", body, idempotencyKey, nil)
 	if err != nil {
 		return err
 	}
 
-	a.Log.Printf("post: %s", path)
-	return a.doRequest(ctx, req, responseMapping)
+	return a.doRequest(ctx, newCall(http.MethodPost, path, authorization, req, formData), responseMapping)
 }
 
 func (a *ApiClient) buildRequest(
@@ -231,7 +270,7 @@ func (a *ApiClient) buildRequest(
 	path string,
 	authorization *configuration.SdkAuthorization,
 	contentType string,
-	body *bytes.Buffer,
+	body io.Reader,
 	idempotencyKey *string,
 	request interface{},
 ) (*http.Request, error) {
@@ -250,22 +289,41 @@ func (a *ApiClient) buildRequest(
 
 	req.Header = a.getHeaders(contentType, authorizationHeader, idempotencyKey, request)
 
+	options := RequestOptionsFromContext(ctx)
+	for key, values := range options.Headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	if options.ApiVersion != "" {
+		req.Header.Set(CkoVersion, options.ApiVersion)
+	}
+
 	return req, nil
 }
 
 func (a *ApiClient) handleResponse(ctx context.Context, rawResponse *http.Response, responseMapping interface{}) error {
-	requestId := rawResponse.Header.Get(CkoRequestId)
-	version := rawResponse.Header.Get(CkoVersion)
+	if stream, ok := responseMapping.(*common.StreamResponse); ok && rawResponse.StatusCode < http.StatusBadRequest {
+		stream.HttpMetadata = a.responseMetadata(rawResponse, nil)
+		stream.Body = rawResponse.Body
+		return nil
+	}
+
 	body, err := a.readBody(ctx, rawResponse)
 	if err != nil {
 		return err
 	}
 
 	if rawResponse.StatusCode >= http.StatusBadRequest {
-		return errors.HandleError(rawResponse.StatusCode, rawResponse.Status, requestId, body)
+		return errors.HandleError(rawResponse.StatusCode, rawResponse.Status, rawResponse.Header.Get(CkoRequestId), body)
 	}
 
-	metadata := &common.HttpMetadata{
+	metadata := a.responseMetadata(rawResponse, body)
+	return common.Unmarshal(&metadata, responseMapping)
+}
+
+func (a *ApiClient) responseMetadata(rawResponse *http.Response, body []byte) common.HttpMetadata {
+	requestId := rawResponse.Header.Get(CkoRequestId)
+	version := rawResponse.Header.Get(CkoVersion)
+	metadata := common.HttpMetadata{
 		Status:       rawResponse.Status,
 		StatusCode:   rawResponse.StatusCode,
 		ResponseBody: body,
@@ -275,8 +333,10 @@ func (a *ApiClient) handleResponse(ctx context.Context, rawResponse *http.Respon
 			CKOVersion:   &version,
 		},
 	}
-
-	return common.Unmarshal(metadata, responseMapping)
+	if rawResponse.Request != nil {
+		metadata.IdempotencyKey = rawResponse.Request.Header.Get(CkoIdempotencyKey)
+	}
+	return metadata
 }
 
 func (a *ApiClient) getHeaders(contentType string, authorization string, idempotencyKey *string, request interface{}) http.Header {
@@ -289,7 +349,7 @@ func (a *ApiClient) getHeaders(contentType string, authorization string, idempot
 		headers.Set("Authorization", authorization)
 	}
 	if idempotencyKey != nil {
-		headers.Set("Cko-Idempotency-Key", *idempotencyKey)
+		headers.Set(CkoIdempotencyKey, *idempotencyKey)
 	}
 
 	applyRequestHeaders(request, headers)
@@ -318,36 +378,39 @@ func (a *ApiClient) readBody(ctx context.Context, response *http.Response) ([]by
 	return body, err
 }
 
-func (a *ApiClient) doRequest(ctx context.Context, req *http.Request, responseMapping interface{}) error {
-	if a.EnableTelemetry {
-		currentRequestId := uuid.New().String()
-		var lastRequestMetric common.RequestMetrics
-		lastRequestMetric, ok := a.RequestMetricsQueue.Dequeue()
-		if ok {
-			lastRequestMetric.RequestId = currentRequestId
-			lastRequestMetricStr, err := json.Marshal(lastRequestMetric)
-			if err != nil {
-				return err
-			}
-			req.Header.Set(CkoTelemetryHeader, string(lastRequestMetricStr))
-		}
-		start := time.Now()
-		resp, err := a.HttpClient.Do(req)
-		elapsed := time.Since(start)
-		if err != nil {
-			return err
-		}
+func (a *ApiClient) doRequest(ctx context.Context, call *configuration.Call, responseMapping interface{}) error {
+	start := time.Now()
+	_, call.Stream = responseMapping.(*common.StreamResponse)
+	span := a.startSpan(call)
+
+	// the timeout derives from the context of the request, which carries the span
+	ctx = call.Request.Context()
+	cancel := func() {}
+	if timeout := RequestOptionsFromContext(ctx).Timeout; timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		call.Request = call.Request.WithContext(ctx)
+	}
 
-		lastRequestMetric.PrevRequestDuration = int(elapsed.Milliseconds())
-		lastRequestMetric.PrevRequestId = currentRequestId
-		a.RequestMetricsQueue.Enqueue(lastRequestMetric)
-		return a.handleResponse(ctx, resp, responseMapping)
-	} else {
-		resp, err := a.HttpClient.Do(req)
-		if err != nil {
-			return err
-		}
+	resp, err := a.handler()(call)
+	if err == nil && resp == nil {
+		err = errors.InternalError("middleware chain returned no response")
+	}
+	if err == nil {
+		err = a.handleResponse(ctx, resp, responseMapping)
+	}
+	if apiErr, ok := err.(errors.CheckoutAPIError); ok {
+		apiErr.Operation = call.Operation
+		err = apiErr
+	}
 
-		return a.handleResponse(ctx, resp, responseMapping)
+	// A streamed body outlives the call, its timeout is released when the body is closed
+	if stream, ok := responseMapping.(*common.StreamResponse); ok && err == nil {
+		stream.Body = &contextReader{ctx: ctx, body: stream.Body, cancel: cancel}
+	} else {
+		cancel()
 	}
+
+	endSpan(span, resp, err)
+	a.observe(call, resp, err, time.Since(start))
+	return err
 }
@@ -402,7 +402,7 @@ func TestContextPropagationThroughDoRequest(t *testing.T) {
 	req.Header.Set("Authorization", authHeader)
 
 	var result common.IdResponse
-	err = client.doRequest(ctx, req, &result)
+	err = client.doRequest(ctx, newCall(http.MethodGet, "/test", auth, req, nil), &result)
 
 	assert.Nil(t, err)
 	assert.Equal(t, "propagated-123", result.Id)
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+func TestIdempotencyKey_GeneratedForPostWhenEnabled(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(CkoIdempotencyKey))
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.GenerateIdempotencyKeys = true
+
+	var resp common.IdResponse
+	err := client.Post("/test", testAuth(), map[string]string{}, &resp, nil)
+
+	assert.Nil(t, err)
+	assert.Len(t, received, 1)
+	assert.NotEmpty(t, received[0])
+	assert.Equal(t, received[0], resp.HttpMetadata.IdempotencyKey)
+}
+
+func TestIdempotencyKey_SuppliedKeyIsKept(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(CkoIdempotencyKey)
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.GenerateIdempotencyKeys = true
+
+	key := "my-key"
+	var resp common.IdResponse
+	err := client.Put("/test", testAuth(), map[string]string{}, &resp, &key)
+
+	assert.Nil(t, err)
+	assert.Equal(t, key, received)
+	assert.Equal(t, key, resp.HttpMetadata.IdempotencyKey)
+}
+
+func TestIdempotencyKey_NotGeneratedWhenDisabledOrForGet(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(CkoIdempotencyKey))
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	var resp common.IdResponse
+	assert.Nil(t, client.Post("/test", testAuth(), map[string]string{}, &resp, nil))
+
+	client.GenerateIdempotencyKeys = true
+	assert.Nil(t, client.Get("/test", testAuth(), &resp))
+
+	assert.Equal(t, []string{"", ""}, received)
+	assert.Empty(t, resp.HttpMetadata.IdempotencyKey)
+}
+
+func TestIdempotencyKey_GeneratedKeyIsReusedAcrossRetries(t *testing.T) {
+	var calls int32
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(CkoIdempotencyKey))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client := newRetryClient(server.URL)
+	client.GenerateIdempotencyKeys = true
+
+	var resp common.IdResponse
+	err := client.Post("/test", testAuth(), map[string]string{}, &resp, nil)
+
+	assert.Nil(t, err)
+	assert.Len(t, received, 2)
+	assert.NotEmpty(t, received[0])
+	assert.Equal(t, received[0], received[1])
+	assert.Equal(t, received[0], resp.HttpMetadata.IdempotencyKey)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+// keyFallbackMiddleware sends a call rejected with a 401 once more with the fallback authorization of the call, such
+// as a key replaced by a rotation that is still within its grace period.
+type keyFallbackMiddleware struct{}
+
+func (m *keyFallbackMiddleware) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	resp, err := next(call)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || call.Authorization == nil || call.Authorization.Fallback == nil {
+		return resp, err
+	}
+
+	if call.Request.Body != nil && call.Request.Body != http.NoBody && call.Request.GetBody == nil {
+		return resp, err
+	}
+
+	header, headerErr := call.Authorization.Fallback.GetAuthorizationHeader()
+	if headerErr != nil {
+		return resp, err
+	}
+	req, rewindErr := rewindRequest(call.Request)
+	if rewindErr != nil {
+		return resp, err
+	}
+
+	discardBody(resp)
+	req.Header.Set("Authorization", header)
+	call.Request = req
+	call.Authorization = call.Authorization.Fallback
+	return next(call)
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+func rotatedAuth() *configuration.SdkAuthorization {
+	return &configuration.SdkAuthorization{
+		PlatformType: configuration.Default,
+		Credential:   "new-key",
+		Fallback: &configuration.SdkAuthorization{
+			PlatformType: configuration.Default,
+			Credential:   "old-key",
+		},
+	}
+}
+
+func TestKeyFallback_RetriesUnauthorizedWithPreviousKey(t *testing.T) {
+	var keys, bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		keys = append(keys, r.Header.Get("Authorization"))
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") != "Bearer old-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newTestClient(server.URL).Post("/payments", rotatedAuth(), map[string]string{"reference": "ref"}, &resp, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "ctx-123", resp.Id)
+	assert.Equal(t, []string{"Bearer new-key", "Bearer old-key"}, keys)
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestKeyFallback_RetriesOnce(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newTestClient(server.URL).Get("/payments/pay_123", rotatedAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestKeyFallback_IgnoredWithoutFallback(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newTestClient(server.URL).Get("/payments/pay_123", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	sdkerrors "github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+func (a *ApiClient) observe(call *configuration.Call, resp *http.Response, err error, duration time.Duration) {
+	if a.Metrics == nil {
+		return
+	}
+
+	observation := configuration.RequestObservation{
+		Method:       call.Method,
+		PathTemplate: call.PathTemplate,
+		Operation:    call.Operation,
+		ErrorClass:   classifyError(resp, err),
+		Duration:     duration,
+	}
+	if resp != nil {
+		observation.StatusCode = resp.StatusCode
+	}
+
+	a.Metrics.ObserveRequest(observation)
+}
+
+func classifyError(resp *http.Response, err error) configuration.ErrorClass {
+	if resp != nil {
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			return configuration.RateLimitedError
+		case resp.StatusCode >= http.StatusInternalServerError:
+			return configuration.ServerError
+		case resp.StatusCode >= http.StatusBadRequest:
+			return configuration.ClientError
+		}
+	}
+
+	if err == nil {
+		return configuration.NoError
+	}
+
+	var circuitErr sdkerrors.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		return configuration.CircuitOpen
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return configuration.CanceledError
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return configuration.TimeoutError
+	}
+
+	if resp == nil && isRetryableNetworkError(err) {
+		return configuration.NetworkError
+	}
+
+	return configuration.SdkError
+}
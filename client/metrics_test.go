@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+type recordingMetrics struct {
+	observations []configuration.RequestObservation
+	retries      []string
+}
+
+func (m *recordingMetrics) ObserveRequest(observation configuration.RequestObservation) {
+	m.observations = append(m.observations, observation)
+}
+
+func (m *recordingMetrics) ObserveRetry(method string, pathTemplate string) {
+	m.retries = append(m.retries, method+" "+pathTemplate)
+}
+
+func TestMetrics_ObservesCallAndRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	metrics := &recordingMetrics{}
+	client := newRetryClient(server.URL)
+	client.Metrics = metrics
+
+	var resp common.IdResponse
+	err := client.Get("/payments/pay_y3oqhf46pyzuxjbcn2giaqnb44?expand=true", testAuth(), &resp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /payments/{id}"}, metrics.retries)
+	assert.Len(t, metrics.observations, 1)
+	observation := metrics.observations[0]
+	assert.Equal(t, http.MethodGet, observation.Method)
+	assert.Equal(t, "/payments/{id}", observation.PathTemplate)
+	assert.Equal(t, http.StatusOK, observation.StatusCode)
+	assert.Equal(t, configuration.NoError, observation.ErrorClass)
+	assert.True(t, observation.Duration > 0)
+}
+
+func TestMetrics_ObservesErrorClass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	metrics := &recordingMetrics{}
+	client := newTestClient(server.URL)
+	client.Metrics = metrics
+
+	var resp common.IdResponse
+	assert.NotNil(t, client.Get("/payments", testAuth(), &resp))
+	assert.Equal(t, http.StatusTooManyRequests, metrics.observations[0].StatusCode)
+	assert.Equal(t, configuration.RateLimitedError, metrics.observations[0].ErrorClass)
+}
+
+func TestClassifyError(t *testing.T) {
+	response := func(status int) *http.Response { return &http.Response{StatusCode: status} }
+
+	assert.Equal(t, configuration.NoError, classifyError(response(http.StatusOK), nil))
+	assert.Equal(t, configuration.ClientError, classifyError(response(http.StatusNotFound), nil))
+	assert.Equal(t, configuration.RateLimitedError, classifyError(response(http.StatusTooManyRequests), nil))
+	assert.Equal(t, configuration.ServerError, classifyError(response(http.StatusBadGateway), nil))
+	assert.Equal(t, configuration.CanceledError, classifyError(nil, context.Canceled))
+	assert.Equal(t, configuration.TimeoutError, classifyError(nil, context.DeadlineExceeded))
+	assert.Equal(t, configuration.CircuitOpen, classifyError(nil, errors.CircuitOpenError{}))
+
+	_, err := (&http.Client{Timeout: time.Second}).Get("http://127.0.0.1:1")
+	assert.Equal(t, configuration.NetworkError, classifyError(nil, err))
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+func newCall(
+	method string,
+	path string,
+	authorization *configuration.SdkAuthorization,
+	req *http.Request,
+	payload interface{},
+) *configuration.Call {
+	return &configuration.Call{
+		Method:        method,
+		Path:          path,
+		PathTemplate:  templatePath(path),
+		Operation:     operationName(),
+		Authorization: authorization,
+		Request:       req,
+		Payload:       payload,
+	}
+}
+
+// handler assembles the middleware chain of the client. Custom middlewares run first, in registration order,
+// followed by the built-in logging, retry, key fallback, circuit breaker, rate limiting and telemetry middlewares.
+// Retries happen inside the chain, so custom middlewares observe a single outcome per call while the circuit breaker,
+// the rate limiter and the telemetry see every attempt.
+func (a *ApiClient) handler() configuration.CallHandler {
+	middlewares := make([]configuration.ClientMiddleware, 0, len(a.Middlewares)+6)
+	middlewares = append(middlewares, a.Middlewares...)
+	middlewares = append(middlewares, a.loggingMiddleware())
+	if a.RetryPolicy != nil {
+		middlewares = append(middlewares, &retryMiddleware{policy: a.RetryPolicy, log: a.Log, metrics: a.Metrics})
+	}
+	middlewares = append(middlewares, &keyFallbackMiddleware{})
+	if a.breaker != nil {
+		middlewares = append(middlewares, a.breaker)
+	}
+	if a.RateLimiter != nil {
+		middlewares = append(middlewares, &rateLimitMiddleware{limiter: a.RateLimiter})
+	}
+	if a.EnableTelemetry {
+		middlewares = append(middlewares, &telemetryMiddleware{queue: &a.RequestMetricsQueue})
+	}
+
+	return chain(middlewares, a.transport)
+}
+
+func (a *ApiClient) transport(call *configuration.Call) (*http.Response, error) {
+	return a.HttpClient.Do(call.Request)
+}
+
+func chain(middlewares []configuration.ClientMiddleware, handler configuration.CallHandler) configuration.CallHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		middleware, next := middlewares[i], handler
+		handler = func(call *configuration.Call) (*http.Response, error) {
+			return middleware.Handle(call, next)
+		}
+	}
+	return handler
+}
+
+func (a *ApiClient) loggingMiddleware() configuration.ClientMiddleware {
+	if a.RequestLogger == nil {
+		return &loggingMiddleware{log: a.Log}
+	}
+
+	redactor := a.Redactor
+	if redactor == nil {
+		redactor = common.DefaultRedactor()
+	}
+	return &requestLoggerMiddleware{logger: a.RequestLogger, redactor: redactor}
+}
+
+type loggingMiddleware struct {
+	log configuration.StdLogger
+}
+
+func (m *loggingMiddleware) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	m.log.Printf("%s: %s", call.Method, call.Path)
+	return next(call)
+}
+
+// telemetryMiddleware reports the duration of the previous request to Checkout through the telemetry header.
+type telemetryMiddleware struct {
+	queue *common.TelemetryQueue
+}
+
+func (m *telemetryMiddleware) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	currentRequestId := uuid.New().String()
+	var lastRequestMetric common.RequestMetrics
+	lastRequestMetric, ok := m.queue.Dequeue()
+	if ok {
+		lastRequestMetric.RequestId = currentRequestId
+		lastRequestMetricStr, err := json.Marshal(lastRequestMetric)
+		if err != nil {
+			return nil, err
+		}
+		call.Request.Header.Set(CkoTelemetryHeader, string(lastRequestMetricStr))
+	}
+	start := time.Now()
+	resp, err := next(call)
+	elapsed := time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+
+	lastRequestMetric.PrevRequestDuration = int(elapsed.Milliseconds())
+	lastRequestMetric.PrevRequestId = currentRequestId
+	m.queue.Enqueue(lastRequestMetric)
+	return resp, nil
+}
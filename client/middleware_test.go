@@ -0,0 +1,139 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+func TestMiddleware_SeesCallAndMutatesHeaders(t *testing.T) {
+	var receivedHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeader = r.Header.Get("X-Audit")
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	var seen configuration.Call
+	client := newTestClient(server.URL)
+	client.Middlewares = []configuration.ClientMiddleware{
+		configuration.ClientMiddlewareFunc(func(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+			seen = *call
+			call.Request.Header.Set("X-Audit", "audited")
+			return next(call)
+		}),
+	}
+
+	payload := map[string]string{"reference": "ref"}
+	var resp common.IdResponse
+	err := client.Post("/payments", testAuth(), payload, &resp, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "audited", receivedHeader)
+	assert.Equal(t, http.MethodPost, seen.Method)
+	assert.Equal(t, "/payments", seen.Path)
+	assert.Equal(t, configuration.Default, seen.Authorization.PlatformType)
+	assert.Equal(t, payload, seen.Payload)
+}
+
+func TestMiddleware_RunInRegistrationOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	var order []string
+	record := func(name string) configuration.ClientMiddleware {
+		return configuration.ClientMiddlewareFunc(func(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+			order = append(order, name+" before")
+			resp, err := next(call)
+			order = append(order, name+" after")
+			return resp, err
+		})
+	}
+
+	client := newTestClient(server.URL)
+	client.Middlewares = []configuration.ClientMiddleware{record("first"), record("second")}
+
+	var resp common.IdResponse
+	assert.Nil(t, client.Get("/test", testAuth(), &resp))
+	assert.Equal(t, []string{"first before", "second before", "second after", "first after"}, order)
+}
+
+func TestMiddleware_ShortCircuitsCall(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.Middlewares = []configuration.ClientMiddleware{
+		configuration.ClientMiddlewareFunc(func(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id":"cached"}`)),
+				Request:    call.Request,
+			}, nil
+		}),
+	}
+
+	var resp common.IdResponse
+	err := client.Get("/test", testAuth(), &resp)
+
+	assert.Nil(t, err)
+	assert.False(t, called)
+	assert.Equal(t, "cached", resp.Id)
+}
+
+func TestMiddleware_ObservesRetriedCallOnce(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	var statuses []int
+	client := newRetryClient(server.URL)
+	client.Middlewares = []configuration.ClientMiddleware{
+		configuration.ClientMiddlewareFunc(func(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+			resp, err := next(call)
+			if resp != nil {
+				statuses = append(statuses, resp.StatusCode)
+			}
+			return resp, err
+		}),
+	}
+
+	var resp common.IdResponse
+	assert.Nil(t, client.Get("/test", testAuth(), &resp))
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []int{http.StatusOK}, statuses)
+}
+
+func TestMiddleware_NilResponseIsAnError(t *testing.T) {
+	client := newTestClient("http://localhost")
+	client.Middlewares = []configuration.ClientMiddleware{
+		configuration.ClientMiddlewareFunc(func(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+			return nil, nil
+		}),
+	}
+
+	var resp common.IdResponse
+	assert.NotNil(t, client.Get("/test", testAuth(), &resp))
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+const (
+	modulePath   = "github.com/checkout/checkout-sdk-go/v2/"
+	clientMethod = ".(*Client)."
+	idSegment    = "{id}"
+
+	freeTextCharacters = "@.%+"
+)
+
+var (
+	prefixedIdPattern = regexp.MustCompile(`^[a-z]{2,6}_[a-z0-9]{16,}$`)
+	uuidPattern       = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	numericPattern    = regexp.MustCompile(`^[0-9]+$`)
+	opaqueIdPattern   = regexp.MustCompile(`^[A-Za-z0-9_-]{20,}$`)
+)
+
+// operationName resolves the name of the domain client method that issued the current call, for example
+// "payments.RequestPayment" for nas.Client.RequestPaymentWithContext. Account system packages (nas, abc) are
+// folded into their parent. An empty string is returned when the call did not originate from a domain client.
+func operationName() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name := operationFromFunction(frame.Function); name != "" {
+			return name
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func operationFromFunction(function string) string {
+	if !strings.HasPrefix(function, modulePath) {
+		return ""
+	}
+
+	qualified := strings.TrimPrefix(function, modulePath)
+	i := strings.Index(qualified, clientMethod)
+	if i < 0 {
+		return ""
+	}
+
+	segments := strings.Split(qualified[:i], "/")
+	if last := segments[len(segments)-1]; len(segments) > 1 && (last == "nas" || last == "abc") {
+		segments = segments[:len(segments)-1]
+	}
+
+	method := strings.TrimSuffix(qualified[i+len(clientMethod):], "WithContext")
+	return strings.Join(segments, ".") + "." + method
+}
+
+// templatePath removes the query string of a path and replaces the segments that look like resource identifiers
+// with "{id}", so that "/payments/pay_y3oqhf46pyzuxjbcn2giaqnb44/refunds" becomes "/payments/{id}/refunds".
+// Free text segments, such as the email "/customers/jane@example.com" is looked up with, are replaced as well, since
+// the routes of the API never contain '@', '.', '%' or '+'. The result keeps the cardinality of metric and span labels
+// bounded, and personal data out of them.
+func templatePath(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIdSegment(segment) {
+			segments[i] = idSegment
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isIdSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	return strings.ContainsAny(segment, freeTextCharacters) ||
+		prefixedIdPattern.MatchString(segment) ||
+		uuidPattern.MatchString(segment) ||
+		numericPattern.MatchString(segment) ||
+		(opaqueIdPattern.MatchString(segment) && strings.ContainsAny(segment, "0123456789"))
+}
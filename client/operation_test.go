@@ -0,0 +1,58 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationFromFunction(t *testing.T) {
+	cases := []struct {
+		function string
+		expected string
+	}{
+		{"github.com/checkout/checkout-sdk-go/v2/payments/nas.(*Client).RequestPaymentWithContext", "payments.RequestPayment"},
+		{"github.com/checkout/checkout-sdk-go/v2/payments/abc.(*Client).RefundPayment", "payments.RefundPayment"},
+		{"github.com/checkout/checkout-sdk-go/v2/disputes.(*Client).QueryWithContext", "disputes.Query"},
+		{"github.com/checkout/checkout-sdk-go/v2/identities/applicants.(*Client).CreateApplicantWithContext", "identities.applicants.CreateApplicant"},
+		{"github.com/checkout/checkout-sdk-go/v2/client.(*ApiClient).invoke", ""},
+		{"github.com/checkout/checkout-sdk-go/v2/mocks.(*ApiClientMock).PostWithContext", ""},
+		{"main.(*Client).Run", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.function, func(t *testing.T) {
+			assert.Equal(t, tc.expected, operationFromFunction(tc.function))
+		})
+	}
+}
+
+func TestOperationName_OutsideDomainClient(t *testing.T) {
+	assert.Equal(t, "", operationName())
+}
+
+func TestTemplatePath(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected string
+	}{
+		{"/payments", "/payments"},
+		{"/payments/pay_y3oqhf46pyzuxjbcn2giaqnb44/refunds", "/payments/{id}/refunds"},
+		{"/payments?limit=10&skip=20", "/payments"},
+		{"/workflows/events/subject/sub_entitylongidentifier/workflow/wf_c7svxlvo2bbuva4f6s3xu4f7wm/reflow",
+			"/workflows/events/subject/{id}/workflow/{id}/reflow"},
+		{"/identity-verifications/b3b4ad42-5a4f-4b8b-a6f0-0f3c9f2a1b2c", "/identity-verifications/{id}"},
+		{"/issuing/cards/crd_fa6psq242dcd6fdn5gifcq1491/3ds-enrollment", "/issuing/cards/{id}/3ds-enrollment"},
+		{"/reports/rpt_lmmldpkfvfduxvhkhnxeqmdcbq/files/file_6lbss42ezvoufcb2beo76rvwly", "/reports/{id}/files/{id}"},
+		{"/metadata/card/123456", "/metadata/card/{id}"},
+		{"/customers/jane@x.com", "/customers/{id}"},
+		{"/customers/jane%40x.com", "/customers/{id}"},
+		{"/customers/jane.doe+test@example.co.uk?limit=1", "/customers/{id}"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.expected, templatePath(tc.path))
+		})
+	}
+}
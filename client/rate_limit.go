@@ -0,0 +1,40 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+// rateLimitMiddleware waits for the rate limiter before every attempt and reports the outcome back to it.
+type rateLimitMiddleware struct {
+	limiter configuration.RateLimiter
+}
+
+func (m *rateLimitMiddleware) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	credential := credentialFingerprint(call.Authorization)
+	if err := m.limiter.Wait(call.Request.Context(), credential, call.Path); err != nil {
+		return nil, err
+	}
+
+	resp, err := next(call)
+	if err != nil {
+		return resp, err
+	}
+
+	retryAfter, _ := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	m.limiter.Observe(credential, call.Path, resp.StatusCode, retryAfter)
+	return resp, nil
+}
+
+// credentialFingerprint identifies a credential without exposing it to the rate limiter.
+func credentialFingerprint(authorization *configuration.SdkAuthorization) string {
+	if authorization == nil {
+		return ""
+	}
+	if authorization.Identity != "" {
+		return configuration.CredentialFingerprint(authorization.Identity)
+	}
+	return configuration.CredentialFingerprint(authorization.Credential)
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+type recordingLimiter struct {
+	waitErr      error
+	waits        []string
+	observations []int
+	retryAfter   time.Duration
+}
+
+func (l *recordingLimiter) Wait(_ context.Context, credential string, path string) error {
+	l.waits = append(l.waits, credential+" "+path)
+	return l.waitErr
+}
+
+func (l *recordingLimiter) Observe(_ string, _ string, statusCode int, retryAfter time.Duration) {
+	l.observations = append(l.observations, statusCode)
+	l.retryAfter = retryAfter
+}
+
+func TestRateLimit_WaitsBeforeEveryAttempt(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	limiter := &recordingLimiter{}
+	client := newRetryClient(server.URL)
+	client.RateLimiter = limiter
+
+	var resp common.IdResponse
+	err := client.Get("/payments/pay_123", testAuth(), &resp)
+
+	assert.Nil(t, err)
+	assert.Len(t, limiter.waits, 2)
+	assert.Equal(t, credentialFingerprint(testAuth())+" /payments/pay_123", limiter.waits[0])
+	assert.Equal(t, []int{http.StatusTooManyRequests, http.StatusOK}, limiter.observations)
+}
+
+func TestRateLimit_ReturnsWaitError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.RateLimiter = &recordingLimiter{waitErr: context.DeadlineExceeded}
+
+	var resp common.IdResponse
+	err := client.Get("/payments", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(0), calls)
+}
+
+func TestRateLimit_FingerprintHidesCredential(t *testing.T) {
+	fingerprint := credentialFingerprint(testAuth())
+
+	assert.NotContains(t, fingerprint, testAuth().Credential)
+	assert.Len(t, fingerprint, 16)
+	assert.Equal(t, "", credentialFingerprint(nil))
+}
+
+func TestRateLimit_FingerprintOfOAuthTokenIsStableAcrossRenewals(t *testing.T) {
+	first := &configuration.SdkAuthorization{PlatformType: configuration.DefaultOAuth, Credential: "token_1", Identity: "client_id"}
+	renewed := &configuration.SdkAuthorization{PlatformType: configuration.DefaultOAuth, Credential: "token_2", Identity: "client_id"}
+
+	assert.Equal(t, credentialFingerprint(first), credentialFingerprint(renewed))
+	assert.Equal(t, configuration.CredentialFingerprint("client_id"), credentialFingerprint(first))
+	assert.Equal(t, configuration.CredentialFingerprint(testAuth().Credential), credentialFingerprint(testAuth()))
+}
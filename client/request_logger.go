@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+// requestLoggerMiddleware hands a redacted record of every call to the configured RequestLogger. Bodies are only read
+// when the logger logs them, and streamed response bodies are never read.
+type requestLoggerMiddleware struct {
+	logger   configuration.RequestLogger
+	redactor *common.Redactor
+}
+
+func (m *requestLoggerMiddleware) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	ctx := call.Request.Context()
+	path := m.redactor.RedactText(call.Path)
+	logsBodies := m.logsBodies()
+
+	record := &configuration.RequestLogRecord{
+		Operation: call.Operation,
+		Method:    call.Method,
+		Path:      path,
+		Headers:   m.redactor.RedactHeaders(call.Request.Header),
+	}
+	if logsBodies {
+		record.Body = m.requestBody(call.Path, call.Request)
+	}
+	m.logger.LogRequest(ctx, record)
+
+	start := time.Now()
+	resp, err := next(call)
+
+	responseRecord := &configuration.ResponseLogRecord{
+		Operation: call.Operation,
+		Method:    call.Method,
+		Path:      path,
+		Duration:  time.Since(start),
+		Err:       err,
+	}
+	if resp != nil {
+		responseRecord.StatusCode = resp.StatusCode
+		responseRecord.RequestId = resp.Header.Get(CkoRequestId)
+		responseRecord.Headers = m.redactor.RedactHeaders(resp.Header)
+		if logsBodies && (!call.Stream || resp.StatusCode >= http.StatusBadRequest) {
+			responseRecord.Body = m.responseBody(call.Path, resp)
+		}
+	}
+	m.logger.LogResponse(ctx, responseRecord)
+
+	return resp, err
+}
+
+func (m *requestLoggerMiddleware) logsBodies() bool {
+	if logger, ok := m.logger.(configuration.BodyRequestLogger); ok {
+		return logger.LogsBodies()
+	}
+	return true
+}
+
+func (m *requestLoggerMiddleware) requestBody(path string, req *http.Request) []byte {
+	if req.GetBody == nil || !isLoggableContentType(req.Header.Get("Content-Type")) {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+	return m.redactor.RedactPathBody(path, content)
+}
+
+// responseBody reads the body of a textual response and puts it back on the response for the caller.
+func (m *requestLoggerMiddleware) responseBody(path string, resp *http.Response) []byte {
+	if resp.Body == nil || !isLoggableContentType(resp.Header.Get("Content-Type")) {
+		return nil
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(content), failingReader{err: err}))
+		return nil
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(content))
+	return m.redactor.RedactPathBody(path, content)
+}
+
+// failingReader replays a read error to the caller once the buffered part of a body has been consumed.
+type failingReader struct {
+	err error
+}
+
+func (r failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func isLoggableContentType(contentType string) bool {
+	return strings.Contains(contentType, "json") || strings.HasPrefix(contentType, "application/x-www-form-urlencoded")
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+type recordingRequestLogger struct {
+	requests  []*configuration.RequestLogRecord
+	responses []*configuration.ResponseLogRecord
+}
+
+func (l *recordingRequestLogger) LogRequest(_ context.Context, record *configuration.RequestLogRecord) {
+	l.requests = append(l.requests, record)
+}
+
+func (l *recordingRequestLogger) LogResponse(_ context.Context, record *configuration.ResponseLogRecord) {
+	l.responses = append(l.responses, record)
+}
+
+func TestRequestLogger_LogsRedactedRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(CkoRequestId, "req-1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"pay_1","source":{"number":"4242424242424242"}}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingRequestLogger{}
+	client := newTestClient(server.URL)
+	client.RequestLogger = logger
+
+	request := map[string]interface{}{
+		"source": map[string]string{"number": "4242424242424242", "cvv": "100"},
+	}
+	var resp common.IdResponse
+	err := client.Post("/payments", testAuth(), request, &resp, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "pay_1", resp.Id, "the response body is still available after logging")
+
+	assert.Len(t, logger.requests, 1)
+	assert.Equal(t, http.MethodPost, logger.requests[0].Method)
+	assert.Equal(t, "/payments", logger.requests[0].Path)
+	assert.Equal(t, common.RedactedValue, logger.requests[0].Headers.Get("Authorization"))
+	assert.JSONEq(t, `{"source":{"number":"************4242","cvv":"[REDACTED]"}}`, string(logger.requests[0].Body))
+
+	assert.Len(t, logger.responses, 1)
+	assert.Equal(t, http.StatusCreated, logger.responses[0].StatusCode)
+	assert.Equal(t, "req-1", logger.responses[0].RequestId)
+	assert.JSONEq(t, `{"id":"pay_1","source":{"number":"************4242"}}`, string(logger.responses[0].Body))
+	assert.Nil(t, logger.responses[0].Err)
+}
+
+func TestRequestLogger_LogsTransportError(t *testing.T) {
+	logger := &recordingRequestLogger{}
+	client := newTestClient("http://127.0.0.1:1")
+	client.RequestLogger = logger
+
+	var resp common.IdResponse
+	err := client.Get("/payments", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Len(t, logger.responses, 1)
+	assert.Equal(t, 0, logger.responses[0].StatusCode)
+	assert.Equal(t, err, logger.responses[0].Err)
+}
+
+func TestRequestLogger_UsesCustomRedactor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	logger := &recordingRequestLogger{}
+	client := newTestClient(server.URL)
+	client.RequestLogger = logger
+	client.Redactor = common.NewRedactor([]string{"email"}, nil)
+
+	var resp common.IdResponse
+	assert.Nil(t, client.Post("/customers", testAuth(), map[string]string{"email": "bruce@wayne.com"}, &resp, nil))
+	assert.JSONEq(t, `{"email":"[REDACTED]"}`, string(logger.requests[0].Body))
+	assert.NotEqual(t, common.RedactedValue, logger.requests[0].Headers.Get("Authorization"))
+}
+
+type headerOnlyRequestLogger struct {
+	recordingRequestLogger
+}
+
+func (l *headerOnlyRequestLogger) LogsBodies() bool {
+	return false
+}
+
+func TestRequestLogger_SkipsBodiesWhenLoggerDoesNotLogThem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	logger := &headerOnlyRequestLogger{}
+	client := newTestClient(server.URL)
+	client.RequestLogger = logger
+
+	var resp common.IdResponse
+	assert.Nil(t, client.Post("/customers", testAuth(), map[string]string{"email": "bruce@wayne.com"}, &resp, nil))
+	assert.Len(t, logger.requests, 1)
+	assert.Nil(t, logger.requests[0].Body)
+	assert.Len(t, logger.responses, 1)
+	assert.Equal(t, http.StatusOK, logger.responses[0].StatusCode)
+	assert.Nil(t, logger.responses[0].Body)
+}
+
+func TestRequestLogger_DoesNotReadStreamedBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"rpt_123"}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingRequestLogger{}
+	client := newTestClient(server.URL)
+	client.RequestLogger = logger
+
+	var stream common.StreamResponse
+	assert.Nil(t, client.Get("/reports/rpt_123/files/file_456", testAuth(), &stream))
+	defer stream.Body.Close()
+
+	assert.Len(t, logger.responses, 1)
+	assert.Nil(t, logger.responses[0].Body)
+	buffered := ioutil.NopCloser(bytes.NewReader(nil))
+	assert.NotEqual(t, reflect.TypeOf(buffered), reflect.TypeOf(stream.Body.(*contextReader).body), "the streamed body is handed over unread")
+}
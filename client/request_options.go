@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+// RequestOption customises a single call. Options are accepted by every WithContext method of the domain clients.
+type RequestOption func(options *RequestOptions)
+
+type RequestOptions struct {
+	// Headers are added to the request, replacing the headers set by the SDK with the same name
+	Headers http.Header
+	// Timeout bounds the wait for an OAuth token, then the whole call, including retries and the download of streamed
+	// bodies
+	Timeout time.Duration
+	// IdempotencyKey takes precedence over the idempotency key passed as a method argument
+	IdempotencyKey *string
+	// ApiVersion is sent in the cko-version header
+	ApiVersion string
+	// Credentials replace the credentials of the configuration for the call
+	Credentials configuration.SdkCredentials
+}
+
+type requestOptionsKey struct{}
+
+func WithHeader(key, value string) RequestOption {
+	return func(options *RequestOptions) {
+		if options.Headers == nil {
+			options.Headers = make(http.Header)
+		}
+		options.Headers.Set(key, value)
+	}
+}
+
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(options *RequestOptions) {
+		options.Timeout = timeout
+	}
+}
+
+func WithIdempotencyKey(idempotencyKey string) RequestOption {
+	return func(options *RequestOptions) {
+		options.IdempotencyKey = &idempotencyKey
+	}
+}
+
+func WithApiVersion(version string) RequestOption {
+	return func(options *RequestOptions) {
+		options.ApiVersion = version
+	}
+}
+
+func WithCredentials(credentials configuration.SdkCredentials) RequestOption {
+	return func(options *RequestOptions) {
+		options.Credentials = credentials
+	}
+}
+
+// WithRequestOptions returns a copy of ctx carrying the options, applied over the options ctx already carries.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	options := RequestOptionsFromContext(ctx)
+	options.Headers = options.Headers.Clone()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, options)
+}
+
+func RequestOptionsFromContext(ctx context.Context) RequestOptions {
+	options, _ := ctx.Value(requestOptionsKey{}).(RequestOptions)
+	return options
+}
+
+// RequestCredentials returns the credentials passed with WithCredentials, or defaults when there are none. Credentials
+// implementing configuration.ContextSdkCredentials resolve their authorization with ctx, which names the operation
+// of the calling client method.
+func RequestCredentials(ctx context.Context, defaults configuration.SdkCredentials) configuration.SdkCredentials {
+	credentials := RequestOptionsFromContext(ctx).Credentials
+	if credentials == nil {
+		credentials = defaults
+	}
+
+	if contextCredentials, ok := credentials.(configuration.ContextSdkCredentials); ok {
+		ctx = configuration.ContextWithOperation(ctx, operationName())
+		return &boundCredentials{ctx: ctx, credentials: contextCredentials}
+	}
+	return credentials
+}
+
+type boundCredentials struct {
+	ctx         context.Context
+	credentials configuration.ContextSdkCredentials
+}
+
+// GetAuthorization returns a rejected token request as the errors.CheckoutAuthorizationError the client methods have
+// always returned. Waiting for a token counts towards the timeout of the call.
+func (b *boundCredentials) GetAuthorization(authorizationType configuration.AuthorizationType) (*configuration.SdkAuthorization, error) {
+	ctx := b.ctx
+	if timeout := RequestOptionsFromContext(ctx).Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	authorization, err := b.credentials.GetAuthorizationWithContext(ctx, authorizationType)
+	if oauthErr, ok := err.(errors.CheckoutOAuthError); ok {
+		return nil, oauthErr.AuthorizationError()
+	}
+	return authorization, err
+}
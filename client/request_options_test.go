@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+func TestRequestOptions_SetsHeaders(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	ctx := WithRequestOptions(context.Background(),
+		WithHeader("X-Correlation-Id", "corr-123"),
+		WithApiVersion("2024-01-01"),
+		WithIdempotencyKey("option-key"))
+
+	var resp common.IdResponse
+	key := "argument-key"
+	err := newTestClient(server.URL).PostWithContext(ctx, "/payments", testAuth(), nil, &resp, &key)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "corr-123", received.Get("X-Correlation-Id"))
+	assert.Equal(t, "2024-01-01", received.Get(CkoVersion))
+	assert.Equal(t, "option-key", received.Get(CkoIdempotencyKey))
+	assert.Equal(t, "option-key", resp.HttpMetadata.IdempotencyKey)
+}
+
+func TestRequestOptions_AppliesTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx := WithRequestOptions(context.Background(), WithTimeout(20*time.Millisecond))
+
+	var resp common.IdResponse
+	start := time.Now()
+	err := newTestClient(server.URL).GetWithContext(ctx, "/payments", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestRequestOptions_TimeoutCoversStreamedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	ctx := WithRequestOptions(context.Background(), WithTimeout(time.Minute))
+
+	var stream common.StreamResponse
+	err := newTestClient(server.URL).GetWithContext(ctx, "/reports", testAuth(), &stream)
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadAll(stream.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "content", string(content))
+	assert.Nil(t, stream.Body.Close())
+	assert.Equal(t, context.Canceled, stream.Body.(*contextReader).ctx.Err())
+}
+
+func TestWithRequestOptions_MergesOptions(t *testing.T) {
+	credentials := configuration.NewDefaultKeysSdkCredentials("sk_test", "pk_test")
+
+	ctx := WithRequestOptions(context.Background(), WithHeader("X-First", "1"), WithTimeout(time.Second))
+	inner := WithRequestOptions(ctx, WithHeader("X-Second", "2"), WithCredentials(credentials))
+
+	options := RequestOptionsFromContext(inner)
+	assert.Equal(t, "1", options.Headers.Get("X-First"))
+	assert.Equal(t, "2", options.Headers.Get("X-Second"))
+	assert.Equal(t, time.Second, options.Timeout)
+	assert.Equal(t, "", RequestOptionsFromContext(ctx).Headers.Get("X-Second"))
+	assert.Equal(t, ctx, WithRequestOptions(ctx))
+
+	defaults := configuration.NewDefaultKeysSdkCredentials("sk_default", "pk_default")
+	assert.Equal(t, credentials, RequestCredentials(inner, defaults))
+	assert.Equal(t, defaults, RequestCredentials(ctx, defaults))
+}
+
+type contextCredentials struct {
+	configuration.SdkCredentials
+	ctx context.Context
+}
+
+func (c *contextCredentials) GetAuthorizationWithContext(ctx context.Context, authorizationType configuration.AuthorizationType) (*configuration.SdkAuthorization, error) {
+	c.ctx = ctx
+	return c.SdkCredentials.GetAuthorization(authorizationType)
+}
+
+func TestRequestCredentials_BindsContext(t *testing.T) {
+	credentials := &contextCredentials{SdkCredentials: configuration.NewDefaultKeysSdkCredentials("sk_test", "pk_test")}
+	ctx := context.WithValue(context.Background(), requestOptionsKey{}, RequestOptions{ApiVersion: "2"})
+
+	authorization, err := RequestCredentials(ctx, credentials).GetAuthorization(configuration.SecretKey)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sk_test", authorization.Credential)
+	assert.Equal(t, "2", RequestOptionsFromContext(credentials.ctx).ApiVersion)
+	assert.Equal(t, "", configuration.OperationFromContext(credentials.ctx), "the test is not a domain client method")
+}
+
+type blockingCredentials struct {
+	configuration.SdkCredentials
+}
+
+func (c *blockingCredentials) GetAuthorizationWithContext(ctx context.Context, _ configuration.AuthorizationType) (*configuration.SdkAuthorization, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestRequestCredentials_TimeoutBoundsTokenWait(t *testing.T) {
+	ctx := WithRequestOptions(context.Background(), WithTimeout(20*time.Millisecond))
+
+	start := time.Now()
+	_, err := RequestCredentials(ctx, &blockingCredentials{}).GetAuthorization(configuration.OAuth)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestRequestOptions_TimeoutKeepsSpanContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	var span interface{}
+	var hasDeadline bool
+	client := newTestClient(server.URL)
+	client.Tracer = &recordingTracer{}
+	client.Middlewares = []configuration.ClientMiddleware{configuration.ClientMiddlewareFunc(
+		func(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+			span = call.Request.Context().Value(spanKey{})
+			_, hasDeadline = call.Request.Context().Deadline()
+			return next(call)
+		})}
+
+	ctx := WithRequestOptions(context.Background(), WithTimeout(time.Minute))
+	var resp common.IdResponse
+	assert.Nil(t, client.GetWithContext(ctx, "/payments", testAuth(), &resp))
+
+	assert.NotNil(t, span)
+	assert.True(t, hasDeadline)
+}
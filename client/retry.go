@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+type retryMiddleware struct {
+	policy  *configuration.RetryPolicy
+	log     configuration.StdLogger
+	metrics configuration.Metrics
+}
+
+func (m *retryMiddleware) Handle(call *configuration.Call, next configuration.CallHandler) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := next(call)
+
+		delay, retry := retryDelay(m.policy, call.Request, attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		discardBody(resp)
+		if m.metrics != nil {
+			m.metrics.ObserveRetry(call.Method, call.PathTemplate)
+		}
+		m.log.Printf("retrying %s: %s in %s (attempt %d of %d)", call.Method, call.Path, delay, attempt+1, m.policy.MaxAttempts)
+		if err = sleep(call.Request.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if call.Request, err = rewindRequest(call.Request); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryDelay decides whether the outcome of an attempt should be retried and how long to wait before doing so.
+// A retry is never scheduled when the wait would outlive the deadline of the request context.
+func retryDelay(policy *configuration.RetryPolicy, req *http.Request, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	ctx := req.Context()
+	if attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	if !isReplayable(req) {
+		return 0, false
+	}
+
+	if err != nil {
+		if !policy.RetryOnNetworkErrors || !isRetryableNetworkError(err) {
+			return 0, false
+		}
+	} else if !policy.IsRetryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	delay := backoff(policy.BaseDelay, policy.MaxDelay, policy.Jitter, attempt)
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok && retryAfter > delay {
+			delay = retryAfter
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// isReplayable reports whether the request can be sent again without risking a duplicate side effect.
+// Non-idempotent methods are only replayed when they carry an idempotency key.
+func isReplayable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return req.Header.Get(CkoIdempotencyKey) != ""
+	}
+}
+
+func isRetryableNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func backoff(base, max time.Duration, jitter float64, attempt int) time.Duration {
+	delay := float64(base) * math.Pow(2, float64(attempt-1))
+	if max > 0 && delay > float64(max) {
+		delay = float64(max)
+	}
+
+	if jitter > 0 {
+		if jitter > 1 {
+			jitter = 1
+		}
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// parseRetryAfter reads a Retry-After header expressed either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
+
+func discardBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+func newRetryClient(baseURL string) *ApiClient {
+	client := newTestClient(baseURL)
+	client.RetryPolicy = &configuration.RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            time.Millisecond,
+		MaxDelay:             5 * time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+		RetryOnNetworkErrors: true,
+	}
+	return client
+}
+
+// failingServer answers with failStatus for the first failures calls and with a JSON body afterwards
+func failingServer(failures int32, failStatus int, calls *int32, bodies *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if bodies != nil {
+			body, _ := ioutil.ReadAll(r.Body)
+			*bodies = append(*bodies, string(body))
+		}
+		if atomic.AddInt32(calls, 1) <= failures {
+			w.WriteHeader(failStatus)
+			return
+		}
+		jsonOK(w)
+	}))
+}
+
+func TestRetry_RetriesRetryableStatus(t *testing.T) {
+	var calls int32
+	server := failingServer(2, http.StatusServiceUnavailable, &calls, nil)
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).Get("/test", testAuth(), &resp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), calls)
+	assert.Equal(t, "ctx-123", resp.Id)
+}
+
+func TestRetry_StopsAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusServiceUnavailable, &calls, nil)
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).Get("/test", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(3), calls)
+	assert.Equal(t, http.StatusServiceUnavailable, err.(errors.CheckoutAPIError).StatusCode)
+}
+
+func TestRetry_DoesNotRetryNonRetryableStatus(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusInternalServerError, &calls, nil)
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).Get("/test", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetry_DisabledWithoutPolicy(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusServiceUnavailable, &calls, nil)
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newTestClient(server.URL).Get("/test", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetry_PostWithoutIdempotencyKeyIsNotRetried(t *testing.T) {
+	var calls int32
+	server := failingServer(10, http.StatusServiceUnavailable, &calls, nil)
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).Post("/test", testAuth(), map[string]string{"a": "b"}, &resp, nil)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetry_PostWithIdempotencyKeyIsRetriedWithSameBody(t *testing.T) {
+	var calls int32
+	var bodies []string
+	server := failingServer(1, http.StatusTooManyRequests, &calls, &bodies)
+	defer server.Close()
+
+	key := "idempotency-key"
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).Post("/test", testAuth(), map[string]string{"a": "b"}, &resp, &key)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), calls)
+	assert.Equal(t, []string{`{"a":"b"}`, `{"a":"b"}`}, bodies)
+}
+
+func TestRetry_RetriesNetworkErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).Get("/test", testAuth(), &resp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestRetry_HonoursRetryAfterWithinDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	var resp common.IdResponse
+	err := newRetryClient(server.URL).GetWithContext(ctx, "/test", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.(errors.CheckoutAPIError).StatusCode)
+	assert.Equal(t, int32(1), calls, "a Retry-After beyond the deadline must not be waited for")
+	assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	delay, ok := parseRetryAfter("3", now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	delay, ok = parseRetryAfter(now.Add(5*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, delay)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, backoff(100*time.Millisecond, time.Second, 0, 1))
+	assert.Equal(t, 400*time.Millisecond, backoff(100*time.Millisecond, time.Second, 0, 3))
+	assert.Equal(t, time.Second, backoff(100*time.Millisecond, time.Second, 0, 10))
+
+	for i := 0; i < 20; i++ {
+		delay := backoff(100*time.Millisecond, time.Second, 0.5, 1)
+		assert.True(t, delay >= 50*time.Millisecond && delay <= 100*time.Millisecond)
+	}
+}
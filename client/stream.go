@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// contextReader stops a streamed download as soon as the context of the call is done, whatever the transport.
+type contextReader struct {
+	ctx    context.Context
+	body   io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.body.Read(p)
+}
+
+func (r *contextReader) Close() error {
+	defer r.cancel()
+	return r.body.Close()
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+func TestStream_ReturnsOpenBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set(CkoRequestId, "req-123")
+		_, _ = w.Write([]byte("header1,header2\nvalue1,value2"))
+	}))
+	defer server.Close()
+
+	var stream common.StreamResponse
+	err := newTestClient(server.URL).Get("/reports/rpt_123/files/file_456", testAuth(), &stream)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, stream.HttpMetadata.StatusCode)
+	assert.Equal(t, "req-123", *stream.HttpMetadata.Headers.CKORequestID)
+	assert.Nil(t, stream.HttpMetadata.ResponseBody)
+
+	content, err := ioutil.ReadAll(stream.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "header1,header2\nvalue1,value2", string(content))
+	assert.Nil(t, stream.Body.Close())
+}
+
+func TestStream_ReturnsApiError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var stream common.StreamResponse
+	err := newTestClient(server.URL).Get("/reports/rpt_123/files/file_456", testAuth(), &stream)
+
+	assert.Equal(t, http.StatusNotFound, err.(errors.CheckoutAPIError).StatusCode)
+	assert.Nil(t, stream.Body)
+}
+
+func TestStream_HonoursContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("header1,header2\n"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var stream common.StreamResponse
+	err := newTestClient(server.URL).GetWithContext(ctx, "/reports/rpt_123/files/file_456", testAuth(), &stream)
+	assert.Nil(t, err)
+	defer stream.Body.Close()
+
+	buf := make([]byte, 16)
+	n, err := stream.Body.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "header1,header2\n", string(buf[:n]))
+
+	cancel()
+	_, err = ioutil.ReadAll(stream.Body)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUpload_StreamsMultipartBody(t *testing.T) {
+	var received struct {
+		filename      string
+		content       string
+		purpose       string
+		contentLength int64
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.contentLength = r.ContentLength
+		file, header, err := r.FormFile("file")
+		if err == nil {
+			content, _ := ioutil.ReadAll(file)
+			received.filename = header.Filename
+			received.content = string(content)
+		}
+		received.purpose = r.FormValue("purpose")
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	request, err := common.BuildFileUploadRequest(&common.FileStream{
+		Reader:      strings.NewReader("evidence content"),
+		Filename:    "evidence.txt",
+		ContentType: "text/plain",
+		Purpose:     common.DisputesEvidence,
+	})
+	assert.Nil(t, err)
+
+	var resp common.IdResponse
+	err = newTestClient(server.URL).Upload("/files", testAuth(), request, &resp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "ctx-123", resp.Id)
+	assert.Equal(t, int64(-1), received.contentLength)
+	assert.Equal(t, "evidence.txt", received.filename)
+	assert.Equal(t, "evidence content", received.content)
+	assert.Equal(t, string(common.DisputesEvidence), received.purpose)
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+const (
+	AttributeHttpMethod     = "http.request.method"
+	AttributeUrlTemplate    = "url.template"
+	AttributeHttpStatusCode = "http.response.status_code"
+	AttributeRequestId      = "checkout.request_id"
+	AttributeVersion        = "checkout.version"
+	AttributeErrorType      = "error.type"
+	AttributeErrorCodes     = "checkout.error_codes"
+)
+
+// startSpan opens a span for the call and propagates its trace context on the outgoing request.
+// It returns nil when no tracer is configured.
+func (a *ApiClient) startSpan(call *configuration.Call) configuration.Span {
+	if a.Tracer == nil {
+		return nil
+	}
+
+	ctx, span := a.Tracer.Start(call.Request.Context(), spanName(call))
+	call.Request = call.Request.WithContext(ctx)
+	a.Tracer.Inject(ctx, call.Request.Header)
+
+	span.SetAttribute(AttributeHttpMethod, call.Method)
+	span.SetAttribute(AttributeUrlTemplate, call.PathTemplate)
+	return span
+}
+
+func endSpan(span configuration.Span, resp *http.Response, err error) {
+	if span == nil {
+		return
+	}
+
+	if resp != nil {
+		span.SetAttribute(AttributeHttpStatusCode, resp.StatusCode)
+		if requestId := resp.Header.Get(CkoRequestId); requestId != "" {
+			span.SetAttribute(AttributeRequestId, requestId)
+		}
+		if version := resp.Header.Get(CkoVersion); version != "" {
+			span.SetAttribute(AttributeVersion, version)
+		}
+	}
+
+	if err != nil {
+		span.SetAttribute(AttributeErrorType, errorType(err))
+		if apiErr, ok := err.(errors.CheckoutAPIError); ok && apiErr.Data != nil && len(apiErr.Data.ErrorCodes) > 0 {
+			span.SetAttribute(AttributeErrorCodes, apiErr.Data.ErrorCodes)
+		}
+		span.RecordError(err)
+	}
+
+	span.End()
+}
+
+func spanName(call *configuration.Call) string {
+	if call.Operation != "" {
+		return call.Operation
+	}
+	return call.Method + " " + call.PathTemplate
+}
+
+func errorType(err error) string {
+	if apiErr, ok := err.(errors.CheckoutAPIError); ok {
+		if apiErr.Data != nil && apiErr.Data.ErrorType != "" {
+			return apiErr.Data.ErrorType
+		}
+		return strconv.Itoa(apiErr.StatusCode)
+	}
+	return fmt.Sprintf("%T", err)
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+type recordingTracer struct {
+	spans []*recordingSpan
+}
+
+type recordingSpan struct {
+	name       string
+	attributes map[string]interface{}
+	errors     []error
+	ended      bool
+}
+
+type spanKey struct{}
+
+func (t *recordingTracer) Start(ctx context.Context, operation string) (context.Context, configuration.Span) {
+	span := &recordingSpan{name: operation, attributes: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (t *recordingTracer) Inject(ctx context.Context, header http.Header) {
+	if span, ok := ctx.Value(spanKey{}).(*recordingSpan); ok {
+		header.Set("traceparent", "00-trace-"+span.name+"-01")
+	}
+}
+
+func (s *recordingSpan) SetAttribute(key string, value interface{}) { s.attributes[key] = value }
+func (s *recordingSpan) RecordError(err error)                      { s.errors = append(s.errors, err) }
+func (s *recordingSpan) End()                                       { s.ended = true }
+
+func TestTracing_SpanForSuccessfulCall(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set(CkoRequestId, "req-1")
+		w.Header().Set(CkoVersion, "1.0")
+		jsonOK(w)
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := newTestClient(server.URL)
+	client.Tracer = tracer
+
+	var resp common.IdResponse
+	err := client.Get("/payments/pay_y3oqhf46pyzuxjbcn2giaqnb44", testAuth(), &resp)
+
+	assert.Nil(t, err)
+	assert.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "GET /payments/{id}", span.name)
+	assert.Equal(t, "00-trace-GET /payments/{id}-01", traceparent)
+	assert.Equal(t, "/payments/{id}", span.attributes[AttributeUrlTemplate])
+	assert.Equal(t, http.MethodGet, span.attributes[AttributeHttpMethod])
+	assert.Equal(t, http.StatusOK, span.attributes[AttributeHttpStatusCode])
+	assert.Equal(t, "req-1", span.attributes[AttributeRequestId])
+	assert.Equal(t, "1.0", span.attributes[AttributeVersion])
+	assert.Empty(t, span.errors)
+	assert.True(t, span.ended)
+}
+
+func TestTracing_SpanForApiError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error_type":"request_invalid","error_codes":["amount_required"]}`))
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := newTestClient(server.URL)
+	client.Tracer = tracer
+
+	var resp common.IdResponse
+	err := client.Post("/payments", testAuth(), map[string]string{}, &resp, nil)
+
+	assert.NotNil(t, err)
+	span := tracer.spans[0]
+	assert.Equal(t, http.StatusUnprocessableEntity, span.attributes[AttributeHttpStatusCode])
+	assert.Equal(t, "request_invalid", span.attributes[AttributeErrorType])
+	assert.Equal(t, []string{"amount_required"}, span.attributes[AttributeErrorCodes])
+	assert.Equal(t, []error{err}, span.errors)
+	assert.True(t, span.ended)
+}
+
+func TestTracing_SpanForTransportError(t *testing.T) {
+	tracer := &recordingTracer{}
+	client := newTestClient("http://127.0.0.1:1")
+	client.Tracer = tracer
+
+	var resp common.IdResponse
+	err := client.Get("/payments", testAuth(), &resp)
+
+	assert.NotNil(t, err)
+	span := tracer.spans[0]
+	assert.Equal(t, "*url.Error", span.attributes[AttributeErrorType])
+	assert.Nil(t, span.attributes[AttributeHttpStatusCode])
+	assert.True(t, span.ended)
+}
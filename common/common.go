@@ -1,6 +1,7 @@
 package common
 
 import (
+	"io"
 	"net/http"
 	"time"
 )
@@ -169,12 +170,20 @@ type (
 		Content      Data `json:"content,omitempty"`
 	}
 
+	// StreamResponse gives access to the body of a file as it is downloaded. The caller must close Body
+	StreamResponse struct {
+		HttpMetadata HttpMetadata
+		Body         io.ReadCloser
+	}
+
 	HttpMetadata struct {
 		Status       string     `json:"status,omitempty"`
 		StatusCode   int        `json:"status_code,omitempty"`
 		ResponseBody []byte     `json:"response_body,omitempty"`
 		ResponseCSV  [][]string `json:"response_csv,omitempty"`
 		Headers      *Headers   `json:"headers,omitempty"`
+		// IdempotencyKey is the Cko-Idempotency-Key sent with the request, either supplied or generated by the SDK
+		IdempotencyKey string `json:"idempotency_key,omitempty"`
 	}
 
 	AlternativeResponse map[string]interface{}
@@ -8,6 +8,7 @@ import (
 	"net/textproto"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/gabriel-vasile/mimetype"
@@ -15,6 +16,9 @@ import (
 	"github.com/checkout/checkout-sdk-go/v2/errors"
 )
 
+// contentTypeDetectionLimit is the number of bytes read from a stream to detect its content type
+const contentTypeDetectionLimit = 3072
+
 type Purpose string
 
 const (
@@ -46,14 +50,32 @@ type (
 		GetFieldName() string
 	}
 
+	// FileStreamUpload is implemented by uploads read from a stream rather than from a local file
+	FileStreamUpload interface {
+		FileUpload
+		GetReader() io.Reader
+		GetContentType() string
+	}
+
 	File struct {
 		File    string
 		Purpose Purpose
 	}
 
+	FileStream struct {
+		Reader io.Reader
+		// Filename is the name given to the uploaded file
+		Filename string
+		// ContentType is detected from the first bytes of Reader when empty
+		ContentType string
+		Purpose     Purpose
+	}
+
 	FileUploadRequest struct {
 		W *multipart.Writer
 		B *bytes.Buffer
+		// Body streams the multipart content of uploads read from a stream, B is nil in that case
+		Body io.ReadCloser
 	}
 
 	FileResponse struct {
@@ -72,6 +94,10 @@ func BuildFileUploadRequest(upload FileUpload) (*FileUploadRequest, error) {
 		return nil, err
 	}
 
+	if stream, ok := upload.(FileStreamUpload); ok {
+		return buildFileStreamUploadRequest(stream)
+	}
+
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 	defer writer.Close()
@@ -108,6 +134,75 @@ func BuildFileUploadRequest(upload FileUpload) (*FileUploadRequest, error) {
 	}, nil
 }
 
+// buildFileStreamUploadRequest encodes the multipart body while it is being sent, so the content of the stream is
+// never held in memory. Only the first bytes are buffered when the content type has to be detected.
+func buildFileStreamUploadRequest(upload FileStreamUpload) (*FileUploadRequest, error) {
+	reader := upload.GetReader()
+	if reader == nil {
+		return nil, errors.BadRequestError("Invalid file reader")
+	}
+
+	contentType := upload.GetContentType()
+	if contentType == "" {
+		header := make([]byte, contentTypeDetectionLimit)
+		n, err := io.ReadFull(reader, header)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		contentType = mimetype.Detect(header[:n]).String()
+		reader = io.MultiReader(bytes.NewReader(header[:n]), reader)
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+	body := &multipartStream{
+		reader: pipeReader,
+		writer: pipeWriter,
+		write: func() error {
+			part, err := createFormFile(writer, upload.GetFieldName(), filepath.Base(upload.GetFile()), contentType)
+			if err != nil {
+				return err
+			}
+
+			if _, err = io.Copy(part, reader); err != nil {
+				return err
+			}
+
+			if err = writer.WriteField("purpose", string(upload.GetPurpose())); err != nil {
+				return err
+			}
+
+			return writer.Close()
+		},
+	}
+
+	return &FileUploadRequest{
+		W:    writer,
+		Body: body,
+	}, nil
+}
+
+// multipartStream starts encoding the multipart body on the first read. Closing it stops the encoding.
+type multipartStream struct {
+	reader *io.PipeReader
+	writer *io.PipeWriter
+	write  func() error
+	once   sync.Once
+}
+
+func (s *multipartStream) Read(p []byte) (int, error) {
+	s.once.Do(func() {
+		go func() {
+			_ = s.writer.CloseWithError(s.write())
+		}()
+	})
+	return s.reader.Read(p)
+}
+
+func (s *multipartStream) Close() error {
+	return s.reader.Close()
+}
+
 func validateFile(f FileUpload) error {
 	if f.GetFile() == "" {
 		return errors.BadRequestError("Invalid file name")
@@ -140,3 +235,23 @@ func (f *File) GetPurpose() Purpose {
 func (f *File) GetFieldName() string {
 	return "file"
 }
+
+func (f *FileStream) GetFile() string {
+	return f.Filename
+}
+
+func (f *FileStream) GetPurpose() Purpose {
+	return f.Purpose
+}
+
+func (f *FileStream) GetFieldName() string {
+	return "file"
+}
+
+func (f *FileStream) GetReader() io.Reader {
+	return f.Reader
+}
+
+func (f *FileStream) GetContentType() string {
+	return f.ContentType
+}
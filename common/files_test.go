@@ -0,0 +1,99 @@
+package common
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readMultipart(t *testing.T, request *FileUploadRequest) map[string]*multipartPart {
+	_, params, err := mime.ParseMediaType(request.W.FormDataContentType())
+	assert.Nil(t, err)
+
+	parts := make(map[string]*multipartPart)
+	reader := multipart.NewReader(request.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		content, _ := ioutil.ReadAll(part)
+		parts[part.FormName()] = &multipartPart{
+			filename:    part.FileName(),
+			contentType: part.Header.Get("Content-Type"),
+			content:     string(content),
+		}
+	}
+	return parts
+}
+
+type multipartPart struct {
+	filename    string
+	contentType string
+	content     string
+}
+
+func TestBuildFileUploadRequest_Stream(t *testing.T) {
+	content := "%PDF-1.4\n" + strings.Repeat("x", 10000)
+	request, err := BuildFileUploadRequest(&FileStream{
+		Reader:   strings.NewReader(content),
+		Filename: "evidence/receipt.pdf",
+		Purpose:  DisputesEvidence,
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, request.B)
+	parts := readMultipart(t, request)
+	assert.Equal(t, "receipt.pdf", parts["file"].filename)
+	assert.Equal(t, "application/pdf", parts["file"].contentType)
+	assert.Equal(t, content, parts["file"].content)
+	assert.Equal(t, string(DisputesEvidence), parts["purpose"].content)
+}
+
+func TestBuildFileUploadRequest_StreamWithContentType(t *testing.T) {
+	request, err := BuildFileUploadRequest(&FileStream{
+		Reader:      bytes.NewReader([]byte("plain content")),
+		Filename:    "document.bin",
+		ContentType: "image/jpeg",
+		Purpose:     DisputesEvidence,
+	})
+
+	assert.Nil(t, err)
+	parts := readMultipart(t, request)
+	assert.Equal(t, "image/jpeg", parts["file"].contentType)
+	assert.Equal(t, "plain content", parts["file"].content)
+}
+
+func TestBuildFileUploadRequest_StreamValidation(t *testing.T) {
+	_, err := BuildFileUploadRequest(&FileStream{Reader: strings.NewReader("content"), Purpose: DisputesEvidence})
+	assert.Equal(t, "Invalid file name", err.Error())
+
+	_, err = BuildFileUploadRequest(&FileStream{Filename: "receipt.pdf", Purpose: DisputesEvidence})
+	assert.Equal(t, "Invalid file reader", err.Error())
+
+	_, err = BuildFileUploadRequest(&FileStream{Reader: strings.NewReader("content"), Filename: "receipt.pdf"})
+	assert.Equal(t, "Invalid purpose", err.Error())
+}
+
+func TestBuildFileUploadRequest_StreamClose(t *testing.T) {
+	request, err := BuildFileUploadRequest(&FileStream{
+		Reader:      strings.NewReader(strings.Repeat("x", 100000)),
+		Filename:    "receipt.pdf",
+		ContentType: "application/pdf",
+		Purpose:     DisputesEvidence,
+	})
+	assert.Nil(t, err)
+
+	buf := make([]byte, 10)
+	_, err = request.Body.Read(buf)
+	assert.Nil(t, err)
+	assert.Nil(t, request.Body.Close())
+
+	_, err = request.Body.Read(buf)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,180 @@
+//go:build go1.23
+
+package common
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+type (
+	// Page holds the items of a page and the cursor of the following one, empty on the last page
+	Page[T any] struct {
+		Items []T
+		Next  string
+	}
+
+	// PageFunc fetches the page identified by cursor. The first page is requested with an empty cursor
+	PageFunc[T any] func(ctx context.Context, cursor string) (Page[T], error)
+
+	pageResult[T any] struct {
+		page Page[T]
+		err  error
+	}
+)
+
+// Pager fetches the pages of a list endpoint lazily, one request per call to Next. A Pager is not safe for concurrent
+// use.
+type Pager[T any] struct {
+	fetch    PageFunc[T]
+	cursor   string
+	done     bool
+	prefetch bool
+	pending  chan pageResult[T]
+}
+
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// NewOffsetPager pages through an endpoint paginated with skip and limit, starting at skip. fetch returns the items
+// of the requested page and the total count of items, or 0 when the endpoint does not report it. Paging stops at the
+// first empty page or once the total count is reached. Without a total count, it also stops at the first page shorter
+// than limit; with one, short pages are followed, as servers may return fewer items than requested.
+func NewOffsetPager[T any](skip, limit int, fetch func(ctx context.Context, skip, limit int) ([]T, int, error)) *Pager[T] {
+	return NewPager(func(ctx context.Context, cursor string) (Page[T], error) {
+		offset := skip
+		if cursor != "" {
+			var err error
+			if offset, err = strconv.Atoi(cursor); err != nil {
+				return Page[T]{}, err
+			}
+		}
+
+		items, total, err := fetch(ctx, offset, limit)
+		if err != nil {
+			return Page[T]{}, err
+		}
+
+		page := Page[T]{Items: items}
+		next := offset + len(items)
+		more := next < total
+		if total <= 0 {
+			more = limit <= 0 || len(items) >= limit
+		}
+		if len(items) > 0 && more {
+			page.Next = strconv.Itoa(next)
+		}
+		return page, nil
+	})
+}
+
+// WithPrefetch makes the pager request the following page in the background as soon as a page is returned, so that
+// it is usually available by the time the caller is done with the current one.
+func (p *Pager[T]) WithPrefetch() *Pager[T] {
+	p.prefetch = true
+	return p
+}
+
+// More reports whether there are pages left to fetch.
+func (p *Pager[T]) More() bool {
+	return !p.done
+}
+
+// Next returns the items of the following page. It returns nil once there are no more pages. When a page cannot be
+// fetched the error is returned and the same page is requested again by the following call.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	var result pageResult[T]
+	if p.pending != nil {
+		select {
+		case result = <-p.pending:
+			p.pending = nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	} else {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result.page, result.err = p.fetch(ctx, p.cursor)
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	p.cursor = result.page.Next
+	p.done = p.cursor == ""
+	if p.prefetch && !p.done {
+		pending := make(chan pageResult[T], 1)
+		go func(cursor string) {
+			page, err := p.fetch(ctx, cursor)
+			pending <- pageResult[T]{page: page, err: err}
+		}(p.cursor)
+		p.pending = pending
+	}
+
+	return result.page.Items, nil
+}
+
+// Pages returns an iterator over the remaining pages. Iteration stops after the first error.
+func (p *Pager[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer p.discardPrefetch()
+		defer cancel()
+
+		for p.More() {
+			items, err := p.Next(ctx)
+			if !yield(items, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the items of the remaining pages. Iteration stops after the first error.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for items, err := range p.Pages(ctx) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// discardPrefetch drops a page prefetched with the context of an iteration that ended early, once the request, which
+// is cancelled beforehand, has returned. The page is requested again if the pager is used afterwards.
+func (p *Pager[T]) discardPrefetch() {
+	if p.pending != nil {
+		<-p.pending
+		p.pending = nil
+	}
+}
+
+// NextPaginationToken returns the pagination token of the "next" link of a page, or an empty string on the last page.
+func NextPaginationToken(links map[string]Link) string {
+	next, ok := links["next"]
+	if !ok || next.HRef == nil {
+		return ""
+	}
+
+	href, err := url.Parse(*next.HRef)
+	if err != nil {
+		return ""
+	}
+	return href.Query().Get("pagination_token")
+}
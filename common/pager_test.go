@@ -0,0 +1,195 @@
+//go:build go1.23
+
+package common
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func numbers(total int) func(ctx context.Context, skip, limit int) ([]int, int, error) {
+	return func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		var items []int
+		for i := skip; i < total && i < skip+limit; i++ {
+			items = append(items, i)
+		}
+		return items, total, nil
+	}
+}
+
+func collect(t *testing.T, seq func(func(int, error) bool)) []int {
+	var items []int
+	for item, err := range seq {
+		assert.Nil(t, err)
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestOffsetPager_FetchesEveryPage(t *testing.T) {
+	var calls int32
+	fetch := numbers(7)
+	pager := NewOffsetPager(0, 3, func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		atomic.AddInt32(&calls, 1)
+		return fetch(ctx, skip, limit)
+	})
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, collect(t, pager.All(context.Background())))
+	assert.Equal(t, int32(3), calls)
+	assert.False(t, pager.More())
+}
+
+func TestOffsetPager_StopsOnEmptyPageWithoutTotal(t *testing.T) {
+	fetch := numbers(4)
+	pager := NewOffsetPager(1, 0, func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		items, _, err := fetch(ctx, skip, 2)
+		return items, 0, err
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, collect(t, pager.All(context.Background())))
+}
+
+func TestOffsetPager_FollowsShortPagesUntilTotal(t *testing.T) {
+	fetch := numbers(7)
+	var limits []int
+	pager := NewOffsetPager(0, 5, func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		limits = append(limits, limit)
+		return fetch(ctx, skip, 2)
+	})
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, collect(t, pager.All(context.Background())))
+	assert.Equal(t, []int{5, 5, 5, 5}, limits)
+}
+
+func TestPager_FetchesLazily(t *testing.T) {
+	var calls int32
+	fetch := numbers(10)
+	pager := NewOffsetPager(0, 2, func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		atomic.AddInt32(&calls, 1)
+		return fetch(ctx, skip, limit)
+	})
+
+	for item, err := range pager.All(context.Background()) {
+		assert.Nil(t, err)
+		if item == 2 {
+			break
+		}
+	}
+	assert.Equal(t, int32(2), calls)
+
+	items, err := pager.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []int{4, 5}, items)
+}
+
+func TestPager_TokenPages(t *testing.T) {
+	pages := map[string]Page[string]{
+		"":       {Items: []string{"a", "b"}, Next: "second"},
+		"second": {Items: []string{"c"}},
+	}
+	pager := NewPager(func(ctx context.Context, cursor string) (Page[string], error) {
+		return pages[cursor], nil
+	})
+
+	var got [][]string
+	for items, err := range pager.Pages(context.Background()) {
+		assert.Nil(t, err)
+		got = append(got, items)
+	}
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, got)
+}
+
+func TestPager_StopsOnErrorAndRetriesSamePage(t *testing.T) {
+	failures := 1
+	pager := NewPager(func(ctx context.Context, cursor string) (Page[int], error) {
+		if cursor == "1" && failures > 0 {
+			failures--
+			return Page[int]{}, errors.New("unavailable")
+		}
+		n, _ := strconv.Atoi(cursor)
+		page := Page[int]{Items: []int{n}}
+		if n < 2 {
+			page.Next = strconv.Itoa(n + 1)
+		}
+		return page, nil
+	})
+
+	var items []int
+	var errs []error
+	for item, err := range pager.All(context.Background()) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0}, items)
+	assert.Len(t, errs, 1)
+
+	assert.Equal(t, []int{1, 2}, collect(t, pager.All(context.Background())))
+}
+
+func TestPager_RespectsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pager := NewOffsetPager(0, 1, numbers(5))
+
+	var items []int
+	var lastErr error
+	for item, err := range pager.All(ctx) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		items = append(items, item)
+		cancel()
+	}
+	assert.Equal(t, []int{0}, items)
+	assert.True(t, errors.Is(lastErr, context.Canceled))
+}
+
+func TestPager_Prefetch(t *testing.T) {
+	fetched := make(chan int, 10)
+	fetch := numbers(6)
+	pager := NewOffsetPager(0, 2, func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		fetched <- skip
+		return fetch(ctx, skip, limit)
+	}).WithPrefetch()
+
+	items, err := pager.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1}, items)
+	assert.Equal(t, 0, <-fetched)
+	assert.Equal(t, 2, <-fetched, "the second page is requested before it is asked for")
+
+	assert.Equal(t, []int{2, 3, 4, 5}, collect(t, pager.All(context.Background())))
+}
+
+func TestNextPaginationToken(t *testing.T) {
+	next := "https://api.checkout.com/reports?limit=5&pagination_token=abc123"
+	assert.Equal(t, "abc123", NextPaginationToken(map[string]Link{"next": {HRef: &next}}))
+	assert.Equal(t, "", NextPaginationToken(map[string]Link{"self": {HRef: &next}}))
+	assert.Equal(t, "", NextPaginationToken(nil))
+}
+
+func TestPager_IteratesAgainAfterBreakingOutWithPrefetch(t *testing.T) {
+	query := struct{ skip, limit int }{}
+	fetch := numbers(6)
+	pager := NewOffsetPager(0, 2, func(ctx context.Context, skip, limit int) ([]int, int, error) {
+		query.skip, query.limit = skip, limit
+		return fetch(ctx, query.skip, query.limit)
+	}).WithPrefetch()
+
+	for item, err := range pager.All(context.Background()) {
+		assert.Nil(t, err)
+		assert.Equal(t, 0, item)
+		break
+	}
+
+	assert.Equal(t, []int{2, 3, 4, 5}, collect(t, pager.All(context.Background())))
+}
@@ -0,0 +1,266 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const RedactedValue = "[REDACTED]"
+
+// DefaultRedactedFields lists the JSON fields and form keys that never reach a log or a recording in clear text.
+// Card numbers keep their last four digits, every other field is replaced entirely.
+var DefaultRedactedFields = []string{
+	"number",
+	"card_number",
+	"encrypted_card_number",
+	"account_number",
+	"cvv",
+	"token",
+	"card_token",
+	"access_token",
+	"cryptogram",
+	"network_token",
+	"token_data",
+	"password",
+	"secret_key",
+	"client_secret",
+	"session_secret",
+	"payment_session_secret",
+}
+
+// DefaultRedactedPathFields lists, by API path, the JSON fields that are only redacted in the bodies of that path and
+// of the paths below it, such as the plaintext value of a Forward secret.
+var DefaultRedactedPathFields = map[string][]string{
+	"/forward/secrets": {"value"},
+}
+
+// DefaultRedactedHeaders lists the HTTP headers that are redacted, both in the headers of a call and as keys of a JSON
+// body, compared case-insensitively. Bodies also have the values of "headers.raw" and "headers.encrypted" redacted,
+// which the Forward API sends to third parties.
+var DefaultRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+var panPattern = regexp.MustCompile(`\b[0-9]{13,19}\b`)
+
+type Redactor struct {
+	fields     map[string]struct{}
+	headers    map[string]struct{}
+	pathFields map[string]map[string]struct{}
+	formFields *regexp.Regexp
+}
+
+func NewRedactor(fields []string, headers []string) *Redactor {
+	r := &Redactor{
+		fields:  make(map[string]struct{}, len(fields)),
+		headers: make(map[string]struct{}, len(headers)),
+	}
+
+	quoted := make([]string, 0, len(fields))
+	for _, field := range fields {
+		r.fields[strings.ToLower(field)] = struct{}{}
+		quoted = append(quoted, regexp.QuoteMeta(field))
+	}
+	for _, header := range headers {
+		r.headers[http.CanonicalHeaderKey(header)] = struct{}{}
+	}
+	if len(quoted) > 0 {
+		r.formFields = regexp.MustCompile(`(?i)(^|[&?\s])(` + strings.Join(quoted, "|") + `)=([^&\s]*)`)
+	}
+
+	return r
+}
+
+func DefaultRedactor() *Redactor {
+	return NewRedactor(DefaultRedactedFields, DefaultRedactedHeaders).WithPathFields(DefaultRedactedPathFields)
+}
+
+func (r *Redactor) WithPathFields(pathFields map[string][]string) *Redactor {
+	r.pathFields = make(map[string]map[string]struct{}, len(pathFields))
+	for path, fields := range pathFields {
+		set := make(map[string]struct{}, len(fields))
+		for _, field := range fields {
+			set[strings.ToLower(field)] = struct{}{}
+		}
+		r.pathFields[path] = set
+	}
+	return r
+}
+
+// RedactHeaders returns a copy of the headers with the sensitive values replaced.
+func (r *Redactor) RedactHeaders(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		if _, ok := r.headers[http.CanonicalHeaderKey(key)]; ok {
+			redacted[key] = []string{RedactedValue}
+			continue
+		}
+		redacted[key] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// RedactBody returns a copy of a JSON or form encoded body with the sensitive fields replaced and any card
+// number found in a free text value masked. Bodies in other formats only have their card numbers masked.
+func (r *Redactor) RedactBody(body []byte) []byte {
+	return r.redactBody(body, nil)
+}
+
+// RedactPathBody redacts a body as RedactBody does, along with the fields redacted for the API path it is sent to or
+// received from.
+func (r *Redactor) RedactPathBody(path string, body []byte) []byte {
+	return r.redactBody(body, r.fieldsOfPath(path))
+}
+
+func (r *Redactor) redactBody(body []byte, pathFields map[string]struct{}) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err == nil {
+		if redacted, err := json.Marshal(r.redactValue("", document, pathFields)); err == nil {
+			return redacted
+		}
+	}
+
+	text := string(body)
+	if r.formFields != nil {
+		text = r.formFields.ReplaceAllString(text, "${1}${2}="+RedactedValue)
+	}
+	return []byte(r.RedactText(text))
+}
+
+// RedactText masks every card number found in the text, keeping only its last four digits.
+func (r *Redactor) RedactText(text string) string {
+	return panPattern.ReplaceAllStringFunc(text, func(candidate string) string {
+		if !luhnValid(candidate) {
+			return candidate
+		}
+		return maskDigits(candidate)
+	})
+}
+
+// fieldsOfPath returns the fields redacted for the path, ignoring its query and any base path it is prefixed with.
+func (r *Redactor) fieldsOfPath(path string) map[string]struct{} {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	for prefix, fields := range r.pathFields {
+		if strings.Contains(path+"/", strings.TrimSuffix(prefix, "/")+"/") {
+			return fields
+		}
+	}
+	return nil
+}
+
+func (r *Redactor) redactValue(key string, value interface{}, pathFields map[string]struct{}) interface{} {
+	if _, sensitive := r.fields[strings.ToLower(key)]; sensitive {
+		return redactField(value)
+	}
+	if _, sensitive := pathFields[strings.ToLower(key)]; sensitive {
+		return redactField(value)
+	}
+	if _, sensitive := r.headers[http.CanonicalHeaderKey(key)]; sensitive && key != "" {
+		return redactField(value)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if strings.EqualFold(key, "headers") {
+			return r.redactHeadersObject(v, pathFields)
+		}
+		for k, nested := range v {
+			v[k] = r.redactValue(k, nested, pathFields)
+		}
+		return v
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = r.redactValue("", nested, pathFields)
+		}
+		return v
+	case string:
+		return r.RedactText(v)
+	default:
+		return v
+	}
+}
+
+// redactHeadersObject redacts the headers a request sends to a third party, as the Forward API carries them: every
+// value of "raw" and the JWE of "encrypted" hold credentials of the destination.
+func (r *Redactor) redactHeadersObject(headers map[string]interface{}, pathFields map[string]struct{}) map[string]interface{} {
+	for k, nested := range headers {
+		switch {
+		case strings.EqualFold(k, "encrypted"):
+			headers[k] = redactField(nested)
+		case strings.EqualFold(k, "raw"):
+			if raw, ok := nested.(map[string]interface{}); ok {
+				for name, header := range raw {
+					raw[name] = redactField(header)
+				}
+			} else {
+				headers[k] = redactField(nested)
+			}
+		default:
+			headers[k] = r.redactValue(k, nested, pathFields)
+		}
+	}
+	return headers
+}
+
+func redactField(value interface{}) interface{} {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		text = v
+	case json.Number:
+		text = v.String()
+	default:
+		return RedactedValue
+	}
+
+	if len(text) >= 12 && isDigits(text) {
+		return maskDigits(text)
+	}
+	return RedactedValue
+}
+
+func maskDigits(digits string) string {
+	return strings.Repeat("*", len(digits)-4) + digits[len(digits)-4:]
+}
+
+func isDigits(text string) bool {
+	for _, c := range text {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactor_RedactBodyJson(t *testing.T) {
+	body := []byte(`{
+		"amount": 100,
+		"source": {"type": "card", "number": "4242424242424242", "cvv": "100", "expiry_month": 6},
+		"description": "card 4543474002249996 used",
+		"reference": "ORD-1234567890123",
+		"encrypted_card_number": "ZW5jcnlwdGVk",
+		"items": [{"token": "tok_abc"}]
+	}`)
+
+	redacted := string(DefaultRedactor().RedactBody(body))
+
+	assert.JSONEq(t, `{
+		"amount": 100,
+		"source": {"type": "card", "number": "************4242", "cvv": "[REDACTED]", "expiry_month": 6},
+		"description": "card ************9996 used",
+		"reference": "ORD-1234567890123",
+		"encrypted_card_number": "[REDACTED]",
+		"items": [{"token": "[REDACTED]"}]
+	}`, redacted)
+}
+
+func TestRedactor_RedactBodyForm(t *testing.T) {
+	body := []byte("grant_type=client_credentials&client_secret=s3cr3t&scope=vault")
+
+	assert.Equal(t, "grant_type=client_credentials&client_secret=[REDACTED]&scope=vault",
+		string(DefaultRedactor().RedactBody(body)))
+}
+
+func TestRedactor_RedactBodyText(t *testing.T) {
+	assert.Equal(t, "pan ************4242, order 1234567890123",
+		string(DefaultRedactor().RedactBody([]byte("pan 4242424242424242, order 1234567890123"))))
+	assert.Empty(t, DefaultRedactor().RedactBody(nil))
+}
+
+func TestRedactor_RedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer sk_sbox_secret")
+	header.Set("Cko-Idempotency-Key", "key")
+
+	redacted := DefaultRedactor().RedactHeaders(header)
+
+	assert.Equal(t, RedactedValue, redacted.Get("Authorization"))
+	assert.Equal(t, "key", redacted.Get("Cko-Idempotency-Key"))
+	assert.Equal(t, "Bearer sk_sbox_secret", header.Get("Authorization"), "the original headers are left untouched")
+}
+
+func TestRedactor_CustomFields(t *testing.T) {
+	redactor := NewRedactor([]string{"email"}, nil)
+
+	assert.JSONEq(t, `{"email":"[REDACTED]","name":"Bruce"}`,
+		string(redactor.RedactBody([]byte(`{"email":"bruce@wayne.com","name":"Bruce"}`))))
+}
+
+func TestRedactor_RedactBodyHeaderKeys(t *testing.T) {
+	body := []byte(`{
+		"destination_request": {
+			"url": "https://example.com/payments",
+			"headers": {
+				"raw": {"Authorization": "Bearer sk_third_party", "Idempotency-Key": "key_1"},
+				"encrypted": "eyJhbGciOiJSU0EtT0FFUC0yNTYifQ.jwe"
+			}
+		},
+		"metadata": {"authorization": "Basic dXNlcjpwYXNz", "COOKIE": "session=1"}
+	}`)
+
+	assert.JSONEq(t, `{
+		"destination_request": {
+			"url": "https://example.com/payments",
+			"headers": {
+				"raw": {"Authorization": "[REDACTED]", "Idempotency-Key": "[REDACTED]"},
+				"encrypted": "[REDACTED]"
+			}
+		},
+		"metadata": {"authorization": "[REDACTED]", "COOKIE": "[REDACTED]"}
+	}`, string(DefaultRedactor().RedactBody(body)))
+}
+
+func TestRedactor_RedactPathBody(t *testing.T) {
+	body := []byte(`{"name":"api_key","value":"sk_third_party","metadata":{"value":"secret"}}`)
+
+	assert.JSONEq(t, `{"name":"api_key","value":"[REDACTED]","metadata":{"value":"[REDACTED]"}}`,
+		string(DefaultRedactor().RedactPathBody("/forward/secrets", body)))
+	assert.JSONEq(t, `{"name":"api_key","value":"[REDACTED]","metadata":{"value":"[REDACTED]"}}`,
+		string(DefaultRedactor().RedactPathBody("/api/forward/secrets/api_key?version=1", body)))
+	assert.JSONEq(t, string(body), string(DefaultRedactor().RedactPathBody("/forward/secretsmanager", body)))
+	assert.JSONEq(t, string(body), string(DefaultRedactor().RedactBody(body)))
+	assert.JSONEq(t, `{"type":"email","value":"bruce@wayne.com"}`,
+		string(DefaultRedactor().RedactPathBody("/compliance-requests/pay_123", []byte(`{"type":"email","value":"bruce@wayne.com"}`))))
+}
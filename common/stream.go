@@ -0,0 +1,10 @@
+package common
+
+import "io"
+
+// Save copies the body of the stream into w and closes the body, which cannot be read again afterwards. It is not
+// named WriteTo so that io.Copy does not pick it up and close the stream as a side effect.
+func (s *StreamResponse) Save(w io.Writer) (int64, error) {
+	defer s.Body.Close()
+	return io.Copy(w, s.Body)
+}
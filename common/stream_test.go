@@ -0,0 +1,40 @@
+package common
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestStreamResponse_SaveCopiesAndClosesBody(t *testing.T) {
+	body := &closeRecorder{Reader: strings.NewReader("header1,header2\nvalue1,value2")}
+	stream := &StreamResponse{Body: body}
+
+	var out bytes.Buffer
+	written, err := stream.Save(&out)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(out.Len()), written)
+	assert.Equal(t, "header1,header2\nvalue1,value2", out.String())
+	assert.True(t, body.closed)
+}
+
+func TestStreamResponse_IsNotAWriterTo(t *testing.T) {
+	var stream interface{} = &StreamResponse{}
+
+	_, ok := stream.(io.WriterTo)
+	assert.False(t, ok, "io.Copy must not close the stream through WriteTo")
+}
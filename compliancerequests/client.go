@@ -29,8 +29,9 @@ func (c *Client) GetComplianceRequest(paymentId string) (*GetComplianceRequestRe
 
 // GetComplianceRequestWithContext is like GetComplianceRequest but accepts a context for
 // cancellation and deadline propagation.
-func (c *Client) GetComplianceRequestWithContext(ctx context.Context, paymentId string) (*GetComplianceRequestResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetComplianceRequestWithContext(ctx context.Context, paymentId string, opts ...client.RequestOption) (*GetComplianceRequestResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +52,9 @@ func (c *Client) RespondToComplianceRequest(paymentId string, request RespondToC
 
 // RespondToComplianceRequestWithContext is like RespondToComplianceRequest but accepts a
 // context for cancellation and deadline propagation.
-func (c *Client) RespondToComplianceRequestWithContext(ctx context.Context, paymentId string, request RespondToComplianceRequestRequest) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RespondToComplianceRequestWithContext(ctx context.Context, paymentId string, request RespondToComplianceRequestRequest, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,20 @@
+package configuration
+
+import "time"
+
+type CircuitBreakerPolicy struct {
+	// FailureThreshold is the number of consecutive 5xx responses or transport errors that opens the circuit
+	FailureThreshold int
+	// CoolDown is how long the circuit stays open before a probe call is let through
+	CoolDown time.Duration
+	// SuccessThreshold is the number of successful probe calls required to close the circuit again
+	SuccessThreshold int
+}
+
+func DefaultCircuitBreakerPolicy() *CircuitBreakerPolicy {
+	return &CircuitBreakerPolicy{
+		FailureThreshold: 5,
+		CoolDown:         30 * time.Second,
+		SuccessThreshold: 1,
+	}
+}
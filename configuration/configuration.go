@@ -13,6 +13,16 @@ type Configuration struct {
 	EnvironmentSubdomain *EnvironmentSubdomain
 	HttpClient           http.Client
 	Logger               StdLogger
+	RetryPolicy          *RetryPolicy
+
+	GenerateIdempotencyKeys bool
+	Middlewares             []ClientMiddleware
+	Tracer                  Tracer
+	Metrics                 Metrics
+	RequestLogger           RequestLogger
+	Redactor                *common.Redactor
+	RateLimiter             RateLimiter
+	CircuitBreaker          *CircuitBreakerPolicy
 }
 
 func NewConfiguration(
@@ -75,3 +85,37 @@ func NewConfigurationWithSubdomain(
 		Logger:               logger,
 	}
 }
+
+// BaseUri returns the host of the API, on the merchant subdomain when one is configured
+func (c *Configuration) BaseUri() string {
+	return c.subdomainUri(c.Environment.BaseUri(), func(s *EnvironmentSubdomain) string { return s.ApiUrl })
+}
+
+// AuthorizationUri returns the host of the OAuth token endpoint, on the merchant subdomain when one is configured
+func (c *Configuration) AuthorizationUri() string {
+	return c.subdomainUri(c.Environment.AuthorizationUri(), func(s *EnvironmentSubdomain) string { return s.AuthorizationUrl })
+}
+
+// FilesUri returns the host of the Files API, on the merchant subdomain when one is configured
+func (c *Configuration) FilesUri() string {
+	return c.subdomainUri(c.Environment.FilesUri(), func(s *EnvironmentSubdomain) string { return s.FilesUrl })
+}
+
+// TransfersUri returns the host of the Transfers API, on the merchant subdomain when one is configured
+func (c *Configuration) TransfersUri() string {
+	return c.subdomainUri(c.Environment.TransfersUri(), func(s *EnvironmentSubdomain) string { return s.TransfersUrl })
+}
+
+// BalancesUri returns the host of the Balances API, on the merchant subdomain when one is configured
+func (c *Configuration) BalancesUri() string {
+	return c.subdomainUri(c.Environment.BalancesUri(), func(s *EnvironmentSubdomain) string { return s.BalancesUrl })
+}
+
+func (c *Configuration) subdomainUri(environmentUri string, subdomainUri func(*EnvironmentSubdomain) string) string {
+	if c.EnvironmentSubdomain != nil {
+		if uri := subdomainUri(c.EnvironmentSubdomain); uri != "" {
+			return uri
+		}
+	}
+	return environmentUri
+}
@@ -0,0 +1,34 @@
+package configuration
+
+import (
+	"context"
+	"time"
+)
+
+// detachedContext carries the values of a context, such as its trace span, but neither its deadline nor its
+// cancellation
+type detachedContext struct {
+	parent context.Context
+}
+
+// WithoutCancel returns a context holding the values of parent that is not cancelled with it, for work shared by
+// several callers that must outlive the one that started it.
+func WithoutCancel(parent context.Context) context.Context {
+	return detachedContext{parent: parent}
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (c detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
@@ -3,6 +3,7 @@ package configuration
 import (
 	"net/url"
 	"regexp"
+	"strings"
 )
 
 type Environment interface {
@@ -14,17 +15,23 @@ type Environment interface {
 	IsSandbox() bool
 }
 
+// EnvironmentSubdomain holds the hosts of an environment on a merchant subdomain. A host left empty falls back to the
+// host of the environment.
 type EnvironmentSubdomain struct {
 	ApiUrl           string
 	AuthorizationUrl string
+	FilesUrl         string
+	TransfersUrl     string
+	BalancesUrl      string
 }
 
 func NewEnvironmentSubdomain(environment Environment, subdomain string) *EnvironmentSubdomain {
-	apiUrl := createUrlWithSubdomain(environment.BaseUri(), subdomain)
-	authorizationUrl := createUrlWithSubdomain(environment.AuthorizationUri(), subdomain)
 	return &EnvironmentSubdomain{
-		ApiUrl:           apiUrl,
-		AuthorizationUrl: authorizationUrl,
+		ApiUrl:           createUrlWithSubdomain(environment.BaseUri(), subdomain),
+		AuthorizationUrl: createUrlWithSubdomain(environment.AuthorizationUri(), subdomain),
+		FilesUrl:         createUrlWithSubdomain(environment.FilesUri(), subdomain),
+		TransfersUrl:     createUrlWithSubdomain(environment.TransfersUri(), subdomain),
+		BalancesUrl:      createUrlWithSubdomain(environment.BalancesUri(), subdomain),
 	}
 }
 
@@ -116,3 +123,49 @@ func Production() *CheckoutEnv {
 		"https://balances.checkout.com/",
 		false)
 }
+
+// CustomEnvironment sets the URL of every service, for example to point the SDK at a local stand-in server.
+// A service without a URL is sent to ApiUrl, and the OAuth tokens are requested from ApiUrl + "/connect/token"
+// when AuthorizationUrl is empty.
+type CustomEnvironment struct {
+	ApiUrl           string
+	AuthorizationUrl string
+	FilesUrl         string
+	TransfersUrl     string
+	BalancesUrl      string
+	Sandbox          bool
+}
+
+func (e *CustomEnvironment) BaseUri() string {
+	return e.ApiUrl
+}
+
+func (e *CustomEnvironment) AuthorizationUri() string {
+	if e.AuthorizationUrl != "" {
+		return e.AuthorizationUrl
+	}
+	return strings.TrimSuffix(e.ApiUrl, "/") + "/connect/token"
+}
+
+func (e *CustomEnvironment) FilesUri() string {
+	return e.serviceUrl(e.FilesUrl)
+}
+
+func (e *CustomEnvironment) TransfersUri() string {
+	return e.serviceUrl(e.TransfersUrl)
+}
+
+func (e *CustomEnvironment) BalancesUri() string {
+	return e.serviceUrl(e.BalancesUrl)
+}
+
+func (e *CustomEnvironment) IsSandbox() bool {
+	return e.Sandbox
+}
+
+func (e *CustomEnvironment) serviceUrl(url string) string {
+	if url != "" {
+		return url
+	}
+	return e.ApiUrl
+}
@@ -0,0 +1,46 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEnvironmentSubdomain_CoversEveryHost(t *testing.T) {
+	subdomain := NewEnvironmentSubdomain(Production(), "1234prod")
+
+	assert.Equal(t, &EnvironmentSubdomain{
+		ApiUrl:           "https://1234prod.api.checkout.com",
+		AuthorizationUrl: "https://1234prod.access.checkout.com/connect/token",
+		FilesUrl:         "https://1234prod.files.checkout.com/",
+		TransfersUrl:     "https://1234prod.transfers.checkout.com/",
+		BalancesUrl:      "https://1234prod.balances.checkout.com/",
+	}, subdomain)
+}
+
+func TestConfiguration_ServiceUris(t *testing.T) {
+	config := NewConfiguration(nil, nil, Sandbox(), nil, nil)
+	assert.Equal(t, "https://files.sandbox.checkout.com", config.FilesUri())
+
+	config = NewConfigurationWithSubdomain(nil, Sandbox(), NewEnvironmentSubdomain(Sandbox(), "abc1"), nil, nil)
+	assert.Equal(t, "https://abc1.api.sandbox.checkout.com", config.BaseUri())
+	assert.Equal(t, "https://abc1.access.sandbox.checkout.com/connect/token", config.AuthorizationUri())
+	assert.Equal(t, "https://abc1.files.sandbox.checkout.com", config.FilesUri())
+	assert.Equal(t, "https://abc1.transfers.sandbox.checkout.com", config.TransfersUri())
+	assert.Equal(t, "https://abc1.balances.sandbox.checkout.com", config.BalancesUri())
+
+	config.EnvironmentSubdomain = &EnvironmentSubdomain{ApiUrl: "https://abc1.api.sandbox.checkout.com"}
+	assert.Equal(t, "https://abc1.api.sandbox.checkout.com", config.BaseUri())
+	assert.Equal(t, "https://balances.sandbox.checkout.com", config.BalancesUri())
+}
+
+func TestCustomEnvironment(t *testing.T) {
+	environment := &CustomEnvironment{ApiUrl: "http://localhost:8080/", FilesUrl: "http://localhost:8081", Sandbox: true}
+
+	assert.Equal(t, "http://localhost:8080/", environment.BaseUri())
+	assert.Equal(t, "http://localhost:8080/connect/token", environment.AuthorizationUri())
+	assert.Equal(t, "http://localhost:8081", environment.FilesUri())
+	assert.Equal(t, "http://localhost:8080/", environment.TransfersUri())
+	assert.Equal(t, "http://localhost:8080/", environment.BalancesUri())
+	assert.True(t, environment.IsSandbox())
+}
@@ -0,0 +1,38 @@
+package configuration
+
+import "time"
+
+type ErrorClass string
+
+const (
+	NoError          ErrorClass = ""
+	ClientError      ErrorClass = "client_error"
+	ServerError      ErrorClass = "server_error"
+	RateLimitedError ErrorClass = "rate_limited"
+	TimeoutError     ErrorClass = "timeout"
+	CanceledError    ErrorClass = "canceled"
+	NetworkError     ErrorClass = "network"
+	SdkError         ErrorClass = "sdk"
+	CircuitOpen      ErrorClass = "circuit_open"
+)
+
+type (
+	// Metrics receives a measurement for every SDK call. Paths are templated ("/payments/{id}") so that
+	// the cardinality of the series stays bounded.
+	Metrics interface {
+		// ObserveRequest is called once per operation, after every retry has completed
+		ObserveRequest(observation RequestObservation)
+		// ObserveRetry is called every time an attempt is retried
+		ObserveRetry(method string, pathTemplate string)
+	}
+
+	RequestObservation struct {
+		Method       string
+		PathTemplate string
+		Operation    string
+		// StatusCode is zero when no response was received
+		StatusCode int
+		ErrorClass ErrorClass
+		Duration   time.Duration
+	}
+)
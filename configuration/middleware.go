@@ -0,0 +1,57 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	// Call describes a single SDK operation as it travels through the middleware chain
+	Call struct {
+		// Method is the HTTP method of the operation
+		Method string
+		// Path is the logical path of the operation, relative to the base uri of the client
+		Path string
+		// PathTemplate is Path without its query string and with resource identifiers replaced by "{id}"
+		PathTemplate string
+		// Operation names the client method that issued the call, for example "payments.RequestPayment"
+		Operation string
+		// Authorization is the resolved authorization used to sign the request
+		Authorization *SdkAuthorization
+		// Request is the outgoing HTTP request. Middlewares may modify its headers or replace it
+		Request *http.Request
+		// Payload is the typed request object the operation was called with, if any
+		Payload interface{}
+		// Stream is true when the response body is handed to the caller as a stream rather than read by the SDK
+		Stream bool
+	}
+
+	// CallHandler sends a call to the next element of the chain and returns the raw HTTP response
+	CallHandler func(call *Call) (*http.Response, error)
+
+	// ClientMiddleware intercepts every call made by the SDK. An implementation can modify the call before passing
+	// it to next, observe the returned response or error, or short-circuit the call by not invoking next at all
+	ClientMiddleware interface {
+		Handle(call *Call, next CallHandler) (*http.Response, error)
+	}
+
+	// ClientMiddlewareFunc adapts an ordinary function to the ClientMiddleware interface
+	ClientMiddlewareFunc func(call *Call, next CallHandler) (*http.Response, error)
+)
+
+func (f ClientMiddlewareFunc) Handle(call *Call, next CallHandler) (*http.Response, error) {
+	return f(call, next)
+}
+
+type generatedIdempotencyKey struct{}
+
+// ContextWithGeneratedIdempotencyKey marks the requests of the context as carrying an idempotency key generated by the
+// SDK rather than given by the caller, so that a recording can ignore it.
+func ContextWithGeneratedIdempotencyKey(ctx context.Context) context.Context {
+	return context.WithValue(ctx, generatedIdempotencyKey{}, true)
+}
+
+func IsIdempotencyKeyGenerated(ctx context.Context) bool {
+	generated, _ := ctx.Value(generatedIdempotencyKey{}).(bool)
+	return generated
+}
@@ -1,11 +1,14 @@
 package configuration
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/checkout/checkout-sdk-go/v2/errors"
@@ -13,18 +16,42 @@ import (
 
 type (
 	OAuthSdkCredentials struct {
-		//HttpClient
-		ClientId         string
-		ClientSecret     string
-		AuthorizationUri string
-		Scopes           []string
-		AccessToken      *OAuthAccessToken
-		Log              StdLogger
+		// HttpClient sends the token requests. A client with a 5 seconds timeout is used when it is nil
+		HttpClient   *http.Client
+		ClientId     string
+		ClientSecret string
+		// ClientSecretSource resolves the client secret for each token request when it is set, in place of ClientSecret
+		ClientSecretSource SecretSource
+		AuthorizationUri   string
+		Scopes             []string
+		// AccessToken is the cached token. It must not be modified while the credentials are in use
+		AccessToken *OAuthAccessToken
+		Log         StdLogger
+		// RefreshMargin is how long before its expiry the token is renewed in the background, from a timer started
+		// when the token is obtained so that an idle client has a valid token for its next call. DefaultTokenRefreshMargin
+		// is used when it is zero
+		RefreshMargin time.Duration
+		// TokenStore shares the token with other instances, keyed by TokenStoreKey. A token found in the store is used
+		// instead of requesting a new one. The token is only kept in memory when it is nil
+		TokenStore TokenStore
+
+		mu           sync.Mutex
+		memoryStore  *InMemoryTokenStore
+		refresh      *tokenRefresh
+		retryRefresh time.Time
+		timer        *time.Timer
+		closed       bool
+	}
+
+	// tokenRefresh is a token request shared by every caller that needs a token while it is in flight
+	tokenRefresh struct {
+		done chan struct{}
+		err  error
 	}
 
 	OAuthAccessToken struct {
-		Token          string
-		ExpirationDate time.Time
+		Token          string    `json:"token"`
+		ExpirationDate time.Time `json:"expiration_date"`
 	}
 
 	OAuthServiceResponse struct {
@@ -34,18 +61,41 @@ type (
 	}
 )
 
+const (
+	DefaultTokenRefreshMargin = time.Minute
+
+	// tokenRequestTimeout bounds a token request, which outlives the callers waiting for it
+	tokenRequestTimeout = 30 * time.Second
+	// refreshRetryDelay spaces the background refreshes after one has failed
+	refreshRetryDelay = 5 * time.Second
+	// minRefreshDelay keeps a token that is about to expire when it is obtained from being renewed in a loop
+	minRefreshDelay = time.Second
+)
+
 func NewOAuthSdkCredentials(
 	clientId,
 	clientSecret,
 	authorizationUri string,
 	scopes []string,
 	logger StdLogger,
+) (*OAuthSdkCredentials, error) {
+	return NewOAuthSdkCredentialsWithHttpClient(clientId, clientSecret, authorizationUri, scopes, nil, logger)
+}
+
+func NewOAuthSdkCredentialsWithHttpClient(
+	clientId,
+	clientSecret,
+	authorizationUri string,
+	scopes []string,
+	httpClient *http.Client,
+	logger StdLogger,
 ) (*OAuthSdkCredentials, error) {
 	if logger == nil {
 		logger = DefaultLogger()
 	}
 
 	sdkCredentials := OAuthSdkCredentials{
+		HttpClient:       httpClient,
 		ClientId:         clientId,
 		ClientSecret:     clientSecret,
 		AuthorizationUri: authorizationUri,
@@ -61,74 +111,265 @@ func NewOAuthSdkCredentials(
 	return &sdkCredentials, nil
 }
 
+// GetAuthorization returns a rejection of the token endpoint as an errors.CheckoutAuthorizationError, as it always
+// has. GetAuthorizationWithContext returns the errors.CheckoutOAuthError holding the response.
 func (f *OAuthSdkCredentials) GetAuthorization(authorizationType AuthorizationType) (*SdkAuthorization, error) {
+	authorization, err := f.GetAuthorizationWithContext(context.Background(), authorizationType)
+	return authorization, authorizationError(err)
+}
+
+func (f *OAuthSdkCredentials) GetAuthorizationWithContext(ctx context.Context, authorizationType AuthorizationType) (*SdkAuthorization, error) {
 	switch authorizationType {
 	case PublicKeyOrOauth, SecretKeyOrOauth, OAuth:
-		err := f.GetAccessToken()
+		token, err := f.accessToken(ctx)
 		if err != nil {
 			return nil, err
 		}
 		return &SdkAuthorization{
 			PlatformType: DefaultOAuth,
-			Credential:   f.AccessToken.Token,
+			Credential:   token.Token,
+			Identity:     f.ClientId,
 		}, nil
 	default:
 		return nil, errors.CheckoutAuthorizationError("Invalid authorization type")
 	}
 }
 
+// GetAccessToken returns a rejection of the token endpoint as an errors.CheckoutAuthorizationError, as it always has.
 func (f *OAuthSdkCredentials) GetAccessToken() error {
-	if f.AccessToken != nil && f.AccessToken.IsValid() {
-		return nil
+	return authorizationError(f.GetAccessTokenWithContext(context.Background()))
+}
+
+// GetAccessTokenWithContext requests a new access token when the current one has expired. A response of the token
+// endpoint with an error status is returned as an errors.CheckoutOAuthError, which errors.As also matches as an
+// errors.CheckoutAuthorizationError.
+// The token request is shared with the other callers and keeps the values of ctx, but not its cancellation: cancelling
+// ctx only stops waiting for the token, and the request goes on for the next caller.
+func (f *OAuthSdkCredentials) GetAccessTokenWithContext(ctx context.Context) error {
+	_, err := f.accessToken(ctx)
+	return err
+}
+
+// accessToken returns the cached token while it is valid, renewing it in the background once it is within
+// RefreshMargin of its expiry. Otherwise the caller waits for a new token, requested once for all the callers that
+// need it at the same time.
+func (f *OAuthSdkCredentials) accessToken(ctx context.Context) (*OAuthAccessToken, error) {
+	f.mu.Lock()
+	token := f.AccessToken
+	if token != nil && token.IsValid() {
+		now := time.Now()
+		if f.refresh == nil && now.After(f.retryRefresh) && token.ExpirationDate.Sub(now) <= f.refreshMargin() {
+			f.startRefresh(ctx)
+		}
+		f.mu.Unlock()
+		return token, nil
+	}
+
+	refresh := f.refresh
+	if refresh == nil {
+		refresh = f.startRefresh(ctx)
+	}
+	f.mu.Unlock()
+
+	select {
+	case <-refresh.done:
+		if refresh.err != nil {
+			return nil, refresh.err
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		return f.AccessToken, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// authorizationError converts a rejection of the token endpoint to the errors.CheckoutAuthorizationError returned
+// by the methods without a context.
+func authorizationError(err error) error {
+	if oauthErr, ok := err.(errors.CheckoutOAuthError); ok {
+		return oauthErr.AuthorizationError()
+	}
+	return err
+}
+
+// startRefresh requests a new token in the background, with the values of the ctx of the caller that started it. It
+// must be called with f.mu held.
+func (f *OAuthSdkCredentials) startRefresh(parent context.Context) *tokenRefresh {
+	refresh := &tokenRefresh{done: make(chan struct{})}
+	f.refresh = refresh
+	store := f.tokenStore()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(WithoutCancel(parent), tokenRequestTimeout)
+		defer cancel()
+		token, err := f.fetchToken(ctx, store)
+
+		f.mu.Lock()
+		if err != nil {
+			f.retryRefresh = time.Now().Add(refreshRetryDelay)
+			f.Log.Printf("error requesting OAuth token: %s", err)
+			if f.AccessToken != nil && f.AccessToken.IsValid() {
+				f.scheduleRefresh(f.retryRefresh)
+			}
+		} else {
+			// a new token is not renewed before half of its lifetime, even when it is shorter than the margin
+			now := time.Now()
+			f.retryRefresh = now.Add(token.ExpirationDate.Sub(now) / 2)
+			f.AccessToken = token
+			renewal := token.ExpirationDate.Add(-f.refreshMargin())
+			if renewal.Before(f.retryRefresh) {
+				renewal = f.retryRefresh
+			}
+			f.scheduleRefresh(renewal)
+		}
+		f.refresh = nil
+		refresh.err = err
+		f.mu.Unlock()
+		close(refresh.done)
+	}()
+
+	return refresh
+}
+
+// scheduleRefresh renews the token from a timer at the given time, so that it does not wait for a call to be renewed.
+// It must be called with f.mu held.
+func (f *OAuthSdkCredentials) scheduleRefresh(at time.Time) {
+	if f.closed {
+		return
+	}
+	if f.timer != nil {
+		f.timer.Stop()
+	}
+
+	delay := time.Until(at)
+	if delay < minRefreshDelay {
+		delay = minRefreshDelay
+	}
+	f.timer = time.AfterFunc(delay, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if !f.closed && f.refresh == nil {
+			f.startRefresh(context.Background())
+		}
+	})
+}
+
+// Close stops renewing the token from a timer. The credentials can still be used: a call then requests a new token
+// when the current one has expired, as no timer is started again. Credentials that are discarded without being closed
+// keep renewing their token until the program exits.
+func (f *OAuthSdkCredentials) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+	if f.timer != nil {
+		f.timer.Stop()
+		f.timer = nil
+	}
+	return nil
+}
+
+// tokenStore must be called with f.mu held.
+func (f *OAuthSdkCredentials) tokenStore() TokenStore {
+	if f.TokenStore != nil {
+		return f.TokenStore
+	}
+	if f.memoryStore == nil {
+		f.memoryStore = NewInMemoryTokenStore()
+	}
+	return f.memoryStore
+}
+
+// fetchToken takes the token of the store when it is not about to expire, which happens when another instance has
+// already renewed it, and requests a new token otherwise.
+func (f *OAuthSdkCredentials) fetchToken(ctx context.Context, store TokenStore) (*OAuthAccessToken, error) {
+	key := TokenStoreKey(f.ClientId, f.Scopes)
+	stored, err := store.Get(ctx, key)
+	if err != nil {
+		f.Log.Printf("error reading OAuth token from store: %s", err)
+	} else if stored != nil && stored.IsValid() && time.Until(stored.ExpirationDate) > f.refreshMargin() {
+		return stored, nil
+	}
+
+	token, err := f.requestToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = store.Set(ctx, key, token); err != nil {
+		f.Log.Printf("error writing OAuth token to store: %s", err)
+	}
+	return token, nil
+}
+
+func (f *OAuthSdkCredentials) refreshMargin() time.Duration {
+	if f.RefreshMargin > 0 {
+		return f.RefreshMargin
+	}
+	return DefaultTokenRefreshMargin
+}
+
+func (f *OAuthSdkCredentials) requestToken(ctx context.Context) (*OAuthAccessToken, error) {
+	clientSecret := f.ClientSecret
+	if f.ClientSecretSource != nil {
+		secret, err := f.ClientSecretSource.Secret(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clientSecret = secret
 	}
 
 	data := url.Values{}
 	data.Set("grant_type", "client_credentials")
 	data.Set("client_id", f.ClientId)
-	data.Set("client_secret", f.ClientSecret)
+	data.Set("client_secret", clientSecret)
 	data.Set("scope", strings.Join(f.Scopes, " "))
 
-	req, err := http.NewRequest(http.MethodPost, f.AuthorizationUri, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.AuthorizationUri, strings.NewReader(data.Encode()))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
-	client := http.Client{Timeout: time.Duration(5) * time.Second}
+	client := f.HttpClient
+	if client == nil {
+		client = &http.Client{Timeout: time.Duration(5) * time.Second}
+	}
 
 	f.Log.Printf("post: %s", f.AuthorizationUri)
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		oauthErr := errors.CheckoutOAuthError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			RawBody:    body,
+		}
+		if len(bytes.TrimSpace(body)) > 0 {
+			_ = json.Unmarshal(body, &oauthErr)
+		}
+		return nil, oauthErr
 	}
 
 	var oauthResp OAuthServiceResponse
 	err = json.Unmarshal(body, &oauthResp)
 	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode >= http.StatusBadRequest {
-		var oauthErr errors.CheckoutOAuthError
-		err = json.Unmarshal(body, &oauthErr)
-		if err != nil {
-			return err
-		}
-		return errors.CheckoutAuthorizationError(oauthErr.Description)
+		return nil, err
 	}
 
-	accessToken := &OAuthAccessToken{
+	return &OAuthAccessToken{
 		Token:          oauthResp.AccessToken,
 		ExpirationDate: time.Now().Add(oauthResp.ExpiresIn * time.Second),
-	}
-	f.AccessToken = accessToken
-	return nil
+	}, nil
 }
 
 func (t *OAuthAccessToken) IsValid() bool {
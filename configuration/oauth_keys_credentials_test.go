@@ -0,0 +1,295 @@
+package configuration
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newOAuthCredentials(uri string, client *http.Client) *OAuthSdkCredentials {
+	return &OAuthSdkCredentials{
+		HttpClient:       client,
+		ClientId:         "client_id",
+		ClientSecret:     "client_secret",
+		AuthorizationUri: uri,
+		Scopes:           []string{Gateway},
+		Log:              log.New(ioutil.Discard, "", 0),
+	}
+}
+
+func TestOAuthSdkCredentials_UsesConfiguredHttpClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "client_id", r.Form.Get("client_id"))
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	credentials := newOAuthCredentials(server.URL, &http.Client{Transport: transport})
+
+	authorization, err := credentials.GetAuthorization(OAuth)
+	assert.Nil(t, err)
+	assert.Equal(t, "token", authorization.Credential)
+	assert.Equal(t, "client_id", authorization.Identity)
+	assert.Equal(t, 1, transport.requests)
+	assert.True(t, credentials.AccessToken.ExpirationDate.After(time.Now().Add(59*time.Minute)))
+}
+
+func TestOAuthSdkCredentials_HonoursContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := newOAuthCredentials(server.URL, nil).GetAuthorizationWithContext(ctx, OAuth)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type contextKey struct{}
+
+type contextCapturingTransport struct {
+	contexts chan context.Context
+}
+
+func (t *contextCapturingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.contexts <- req.Context()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestOAuthSdkCredentials_TokenRequestKeepsContextValuesButNotCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	transport := &contextCapturingTransport{contexts: make(chan context.Context, 1)}
+	credentials := newOAuthCredentials(server.URL, &http.Client{Transport: transport})
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), contextKey{}, "span"))
+	cancel()
+	_, err := credentials.GetAuthorizationWithContext(ctx, OAuth)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	requestCtx := <-transport.contexts
+	assert.Equal(t, "span", requestCtx.Value(contextKey{}))
+	assert.Nil(t, requestCtx.Err())
+	_, hasDeadline := requestCtx.Deadline()
+	assert.True(t, hasDeadline)
+
+	authorization, err := credentials.GetAuthorizationWithContext(context.Background(), OAuth)
+	assert.Nil(t, err)
+	assert.Equal(t, "token", authorization.Credential)
+}
+
+func TestOAuthSdkCredentials_ReturnsTokenEndpointError(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		expected errors.CheckoutOAuthError
+	}{
+		{
+			name:   "when the endpoint rejects the client then return its error",
+			status: http.StatusBadRequest,
+			body:   `{"error":"invalid_client"}`,
+			expected: errors.CheckoutOAuthError{
+				Description: "invalid_client",
+				StatusCode:  http.StatusBadRequest,
+				Status:      "400 Bad Request",
+				RawBody:     []byte(`{"error":"invalid_client"}`),
+			},
+		},
+		{
+			name:   "when the body cannot be parsed then keep it",
+			status: http.StatusBadGateway,
+			body:   "<html>Bad Gateway</html>",
+			expected: errors.CheckoutOAuthError{
+				StatusCode: http.StatusBadGateway,
+				Status:     "502 Bad Gateway",
+				RawBody:    []byte("<html>Bad Gateway</html>"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			credentials := newOAuthCredentials(server.URL, nil)
+			err := credentials.GetAccessTokenWithContext(context.Background())
+			assert.Equal(t, tc.expected, err)
+
+			var authorizationError errors.CheckoutAuthorizationError
+			assert.ErrorAs(t, err, &authorizationError)
+			assert.Equal(t, errors.CheckoutAuthorizationError(tc.expected.Error()), authorizationError)
+			assert.Equal(t, authorizationError, credentials.GetAccessToken())
+		})
+	}
+}
+
+func tokenServer(requests *int32, expiresIn int, delay time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(requests, 1)
+		time.Sleep(delay)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token_%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+}
+
+func TestOAuthSdkCredentials_ConcurrentCallersShareOneRequest(t *testing.T) {
+	var requests int32
+	server := tokenServer(&requests, 3600, 50*time.Millisecond)
+	defer server.Close()
+
+	credentials := newOAuthCredentials(server.URL, nil)
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 20)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			authorization, err := credentials.GetAuthorization(OAuth)
+			assert.Nil(t, err)
+			tokens[i] = authorization.Credential
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	for _, token := range tokens {
+		assert.Equal(t, "token_1", token)
+	}
+}
+
+func TestOAuthSdkCredentials_RefreshesBeforeExpiry(t *testing.T) {
+	var requests int32
+	server := tokenServer(&requests, 3600, 0)
+	defer server.Close()
+
+	credentials := newOAuthCredentials(server.URL, nil)
+	credentials.RefreshMargin = 10 * time.Second
+	credentials.AccessToken = &OAuthAccessToken{Token: "expiring", ExpirationDate: time.Now().Add(5 * time.Second)}
+
+	authorization, err := credentials.GetAuthorization(OAuth)
+	assert.Nil(t, err)
+	assert.Equal(t, "expiring", authorization.Credential, "the current token is used while it is renewed")
+
+	assert.Eventually(t, func() bool {
+		authorization, err = credentials.GetAuthorization(OAuth)
+		return err == nil && authorization.Credential == "token_1"
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestOAuthSdkCredentials_RefreshesIdleTokenFromTimerUntilClosed(t *testing.T) {
+	var requests int32
+	server := tokenServer(&requests, 2, 0)
+	defer server.Close()
+
+	credentials := newOAuthCredentials(server.URL, nil)
+	credentials.RefreshMargin = time.Second
+	assert.Nil(t, credentials.GetAccessToken())
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requests) == 2
+	}, 3*time.Second, 10*time.Millisecond, "the token is renewed without any call")
+	authorization, err := credentials.GetAuthorization(OAuth)
+	assert.Nil(t, err)
+	assert.Equal(t, "token_2", authorization.Credential)
+
+	assert.Nil(t, credentials.Close())
+	time.Sleep(1500 * time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "no token is renewed once the credentials are closed")
+}
+
+func TestOAuthSdkCredentials_FailedRefreshIsSharedAndRetried(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	credentials := newOAuthCredentials(server.URL, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := credentials.GetAuthorizationWithContext(context.Background(), OAuth)
+			assert.Equal(t, http.StatusServiceUnavailable, err.(errors.CheckoutOAuthError).StatusCode)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	_, err := credentials.GetAuthorization(OAuth)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func newTLSTokenServer(t *testing.T, tlsConfig *tls.Config) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+	}))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	return server
+}
+
+func TestOAuthSdkCredentials_UsesTransportOptions(t *testing.T) {
+	server := newTLSTokenServer(t, &tls.Config{ClientAuth: tls.RequireAnyClientCert})
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	withoutCertificate, _ := (&TransportOptions{RootCAs: pool}).Apply(nil)
+	assert.NotNil(t, newOAuthCredentials(server.URL, withoutCertificate).GetAccessToken())
+
+	withCertificate, _ := (&TransportOptions{
+		RootCAs:       pool,
+		Certificates:  server.TLS.Certificates,
+		MinTLSVersion: tls.VersionTLS12,
+	}).Apply(nil)
+	assert.Nil(t, newOAuthCredentials(server.URL, withCertificate).GetAccessToken())
+
+	untrusted, _ := (&TransportOptions{Certificates: server.TLS.Certificates}).Apply(nil)
+	assert.NotNil(t, newOAuthCredentials(server.URL, untrusted).GetAccessToken())
+}
@@ -0,0 +1,114 @@
+package configuration
+
+// DefaultOperationScopes maps the operations of the SDK to the narrowest OAuth scopes that allow them. Keys are either
+// an operation, as named by the client, or a package whose operations all share the same scopes. The most specific key
+// wins, so "payments.RefundPayment" takes precedence over "payments". A package key does not cover its subpackages:
+// "payments.links" needs its own entry, and operations without one use every granted scope.
+var DefaultOperationScopes = map[string][]string{
+	"accounts":                  {Accounts},
+	"accounts.SubmitFile":       {FilesUpload},
+	"accounts.SubmitFileStream": {FilesUpload},
+	"accounts.UploadFile":       {FilesUpload},
+	"accounts.UploadFileStream": {FilesUpload},
+	"accounts.RetrieveFile":     {FilesRetrieve},
+
+	"balances": {BalancesView},
+
+	"disputes":                                 {Disputes},
+	"disputes.Query":                           {DisputesView},
+	"disputes.GetDisputeDetails":               {DisputesView},
+	"disputes.Accept":                          {DisputesAccept},
+	"disputes.PutEvidence":                     {DisputesProvideEvidence},
+	"disputes.GetEvidence":                     {DisputesProvideEvidence},
+	"disputes.SubmitEvidence":                  {DisputesProvideEvidence},
+	"disputes.GetCompiledSubmittedEvidence":    {DisputesProvideEvidence},
+	"disputes.SubmitArbitrationEvidence":       {DisputesProvideEvidence},
+	"disputes.GetSubmittedArbitrationEvidence": {DisputesProvideEvidence},
+	"disputes.GetDisputeSchemeFiles":           {DisputesSchemeFiles},
+	"disputes.UploadFile":                      {FilesUpload},
+	"disputes.UploadFileStream":                {FilesUpload},
+	"disputes.GetFileDetails":                  {FilesRetrieve},
+
+	"financial": {FinancialActionsView},
+
+	"forex": {Fx},
+
+	"forward":              {Forward},
+	"forward.CreateSecret": {ForwardSecrets},
+	"forward.ListSecrets":  {ForwardSecrets},
+	"forward.UpdateSecret": {ForwardSecrets},
+	"forward.DeleteSecret": {ForwardSecrets},
+
+	"issuing":                                {IssuingClient},
+	"issuing.CreateCard":                     {IssuingCardMgmt},
+	"issuing.GetCardDetails":                 {IssuingCardMgmt},
+	"issuing.GetCardholderCards":             {IssuingCardMgmt},
+	"issuing.EnrollThreeDS":                  {IssuingCardMgmt},
+	"issuing.UpdateThreeDS":                  {IssuingCardMgmt},
+	"issuing.GetCardThreeDSDetails":          {IssuingCardMgmt},
+	"issuing.ActivateCard":                   {IssuingCardMgmt},
+	"issuing.GetCardCredentials":             {IssuingCardMgmt},
+	"issuing.RevokeCard":                     {IssuingCardMgmt},
+	"issuing.SuspendCard":                    {IssuingCardMgmt},
+	"issuing.UpdateCard":                     {IssuingCardMgmt},
+	"issuing.RenewCard":                      {IssuingCardMgmt},
+	"issuing.ScheduleCardRevocation":         {IssuingCardMgmt},
+	"issuing.DeleteScheduledRevocation":      {IssuingCardMgmt},
+	"issuing.GetDigitalCard":                 {IssuingCardMgmt},
+	"issuing.GetCardControls":                {IssuingControlsRead},
+	"issuing.GetCardControlDetails":          {IssuingControlsRead},
+	"issuing.GetControlGroups":               {IssuingControlsRead},
+	"issuing.GetControlGroupDetails":         {IssuingControlsRead},
+	"issuing.GetAllControlProfiles":          {IssuingControlsRead},
+	"issuing.GetControlProfileDetails":       {IssuingControlsRead},
+	"issuing.CreateControl":                  {IssuingControlsWrite},
+	"issuing.UpdateCardControl":              {IssuingControlsWrite},
+	"issuing.RemoveCardControl":              {IssuingControlsWrite},
+	"issuing.CreateControlGroup":             {IssuingControlsWrite},
+	"issuing.RemoveControlGroup":             {IssuingControlsWrite},
+	"issuing.CreateControlProfile":           {IssuingControlsWrite},
+	"issuing.UpdateControlProfile":           {IssuingControlsWrite},
+	"issuing.RemoveControlProfile":           {IssuingControlsWrite},
+	"issuing.AddTargetToControlProfile":      {IssuingControlsWrite},
+	"issuing.RemoveTargetFromControlProfile": {IssuingControlsWrite},
+	"issuing.GetDispute":                     {IssuingDisputesRead},
+	"issuing.CreateDispute":                  {IssuingDisputesWrite},
+	"issuing.CancelDispute":                  {IssuingDisputesWrite},
+	"issuing.EscalateDispute":                {IssuingDisputesWrite},
+	"issuing.GetListTransactions":            {IssuingTransactionsRead},
+	"issuing.GetSingleTransaction":           {IssuingTransactionsRead},
+
+	"payments":                              {Gateway},
+	"payments.RequestPayment":               {GatewayPayment},
+	"payments.RequestPayout":                {GatewayPayment},
+	"payments.RequestPaymentList":           {GatewayPaymentDetails},
+	"payments.GetPaymentDetails":            {GatewayPaymentDetails},
+	"payments.GetPaymentActions":            {GatewayPaymentDetails},
+	"payments.IncrementAuthorization":       {GatewayPaymentAuthorization},
+	"payments.CapturePayment":               {GatewayPaymentCaptures},
+	"payments.CapturePaymentWithoutRequest": {GatewayPaymentCaptures},
+	"payments.RefundPayment":                {GatewayPaymentRefunds},
+	"payments.VoidPayment":                  {GatewayPaymentVoids},
+	"payments.CancelAScheduledRetry":        {GatewayPaymentCancellations},
+	"payments.SearchPayments":               {PaymentsSearch},
+	"payments.contexts":                     {PaymentContexts},
+	"payments.applepay":                     {VaultApmeEnrollment},
+
+	"reports": {ReportsView},
+
+	"transfers":                          {Transfers},
+	"transfers.InitiateTransferOfFunds":  {TransfersCreate},
+	"transfers.InitiateTransferOfFounds": {TransfersCreate},
+	"transfers.RetrieveTransfer":         {TransfersView},
+
+	"customers":                {VaultCustomers},
+	"instruments":              {VaultInstruments},
+	"metadata":                 {VaultCardMetadata},
+	"networktokens":            {VaultNetworkTokens},
+	"standaloneaccountupdater": {VaultRealTimeAccountUpdater},
+
+	"workflows":                  {FlowWorkflows},
+	"workflows.GetEventTypes":    {FlowEvents},
+	"workflows.GetEvent":         {FlowEvents},
+	"workflows.GetSubjectEvents": {FlowEvents},
+}
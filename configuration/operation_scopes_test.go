@@ -0,0 +1,133 @@
+package configuration
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clientOperations parses the packages of the module and returns the operations of their clients, named as the client
+// names them, grouped by package.
+func clientOperations(t *testing.T) map[string][]string {
+	operations := map[string][]string{}
+	err := filepath.Walk("..", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if name := info.Name(); path != ".." && (name == "opentelemetry" || name == "test" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		dir, err := filepath.Rel("..", filepath.Dir(path))
+		if err != nil || dir == "." {
+			return err
+		}
+		segments := strings.Split(filepath.ToSlash(dir), "/")
+		if last := segments[len(segments)-1]; len(segments) > 1 && (last == "nas" || last == "abc") {
+			segments = segments[:len(segments)-1]
+		}
+		pkg := strings.Join(segments, ".")
+
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv == nil || !function.Name.IsExported() || strings.HasSuffix(function.Name.Name, "WithContext") {
+				continue
+			}
+			if star, ok := function.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+					operations[pkg] = append(operations[pkg], pkg+"."+function.Name.Name)
+				}
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return operations
+}
+
+func declaredScopes(t *testing.T) map[string]bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "oauth_scopes.go", nil, 0)
+	assert.Nil(t, err)
+
+	scopes := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if literal, ok := node.(*ast.BasicLit); ok && literal.Kind == token.STRING {
+			scope, _ := strconv.Unquote(literal.Value)
+			scopes[scope] = true
+		}
+		return true
+	})
+	return scopes
+}
+
+func TestDefaultOperationScopes_MapExistingOperations(t *testing.T) {
+	operations := clientOperations(t)
+	known := map[string]bool{}
+	for pkg, names := range operations {
+		known[pkg] = true
+		for _, name := range names {
+			known[name] = true
+		}
+	}
+	assert.True(t, known["payments.RefundPayment"])
+	assert.True(t, known["payments.links.CreatePaymentLink"])
+
+	scopes := declaredScopes(t)
+	for key, required := range DefaultOperationScopes {
+		assert.True(t, known[key], "%s is neither a client package nor an operation", key)
+		assert.NotEmpty(t, required, key)
+		for _, scope := range required {
+			assert.True(t, scopes[scope], "%s requires the undeclared scope %s", key, scope)
+		}
+	}
+}
+
+func TestDefaultOperationScopes_DoNotApplyParentPackageToSubpackages(t *testing.T) {
+	granted := []string{Gateway, Vault}
+	credentials := &ScopedOAuthSdkCredentials{GrantedScopes: granted, OperationScopes: DefaultOperationScopes}
+
+	operations := clientOperations(t)
+	for pkg, names := range operations {
+		for _, name := range names {
+			expected, ok := DefaultOperationScopes[name]
+			if !ok {
+				expected, ok = DefaultOperationScopes[pkg]
+			}
+			if !ok {
+				expected = granted
+			}
+			assert.Equal(t, expected, credentials.ScopesFor(name), name)
+		}
+	}
+
+	for _, operation := range []string{
+		"payments.sessions.RequestPaymentSessions",
+		"payments.setups.CreatePaymentSetup",
+		"payments.links.CreatePaymentLink",
+		"payments.hosted.CreateHostedPaymentsPageSession",
+		"issuing.cardholdertokens.RequestCardholderToken",
+	} {
+		pkg := operation[:strings.LastIndex(operation, ".")]
+		assert.Contains(t, operations[pkg], operation)
+		assert.Equal(t, granted, credentials.ScopesFor(operation), operation)
+	}
+	assert.Equal(t, []string{VaultApmeEnrollment}, credentials.ScopesFor("payments.applepay.EnrollDomain"))
+}
@@ -0,0 +1,23 @@
+package configuration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// RateLimiter throttles the calls made by the SDK. Credentials are passed as a fingerprint, never in clear text, of
+// their identity: the client id for OAuth, so that a renewed token keeps its limits, and the key otherwise.
+type RateLimiter interface {
+	// Wait blocks until a call to path may be sent with the credential, or returns an error once ctx is done
+	Wait(ctx context.Context, credential string, path string) error
+	// Observe reports the status code and Retry-After delay of a response so the limiter can adapt its rate
+	Observe(credential string, path string, statusCode int, retryAfter time.Duration)
+}
+
+// CredentialFingerprint returns the fingerprint passed to the RateLimiter for a key or an OAuth client id.
+func CredentialFingerprint(credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,43 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type (
+	// RequestLogger receives a structured record for every request sent and every response received by the SDK.
+	// Headers and bodies are redacted before they reach the logger.
+	RequestLogger interface {
+		LogRequest(ctx context.Context, record *RequestLogRecord)
+		LogResponse(ctx context.Context, record *ResponseLogRecord)
+	}
+
+	// BodyRequestLogger is a RequestLogger that tells whether it logs bodies. The bodies are only read and redacted
+	// for it when it does, and always for the loggers that do not implement it
+	BodyRequestLogger interface {
+		RequestLogger
+		LogsBodies() bool
+	}
+
+	RequestLogRecord struct {
+		Operation string
+		Method    string
+		Path      string
+		Headers   http.Header
+		Body      []byte
+	}
+
+	ResponseLogRecord struct {
+		Operation  string
+		Method     string
+		Path       string
+		StatusCode int
+		RequestId  string
+		Duration   time.Duration
+		Headers    http.Header
+		Body       []byte
+		Err        error
+	}
+)
@@ -0,0 +1,46 @@
+package configuration
+
+import (
+	"net/http"
+	"time"
+)
+
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled on every following attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay. A Retry-After header sent by the API takes precedence
+	MaxDelay time.Duration
+	// Jitter is the fraction (0 to 1) of the computed delay that is randomised
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that trigger a retry
+	RetryableStatusCodes []int
+	// RetryOnNetworkErrors enables retries on connection resets, refused connections and transport timeouts
+	RetryOnNetworkErrors bool
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		Jitter:      0.5,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryOnNetworkErrors: true,
+	}
+}
+
+func (p *RetryPolicy) IsRetryableStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
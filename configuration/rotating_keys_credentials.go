@@ -0,0 +1,145 @@
+package configuration
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+// KeySource loads the current keys, for example from a secret manager, for RotatingKeysSdkCredentials.Watch
+type KeySource func(ctx context.Context) (StaticKeys, error)
+
+// RotatingKeysSdkCredentials holds static keys that can be replaced while the SDK is in use. The keys are swapped
+// atomically, so a call uses either the previous or the new pair, never a mix of both.
+type RotatingKeysSdkCredentials struct {
+	// GracePeriod is how long the replaced keys are kept after a rotation. A call rejected with a 401 during that
+	// time is sent once more with the replaced key. Calls are never sent twice when it is zero
+	GracePeriod time.Duration
+	Log         StdLogger
+
+	platformType     PlatformType
+	secretKeyPattern string
+	publicKeyPattern string
+
+	mu        sync.RWMutex
+	keys      StaticKeys
+	previous  StaticKeys
+	rotatedAt time.Time
+}
+
+func NewRotatingKeysSdkCredentials(secretKey, publicKey string, gracePeriod time.Duration) (*RotatingKeysSdkCredentials, error) {
+	return newRotatingKeysSdkCredentials(Default, DefaultSecretKeyPattern, DefaultPublicKeyPattern, secretKey, publicKey, gracePeriod)
+}
+
+func NewRotatingPreviousKeysSdkCredentials(secretKey, publicKey string, gracePeriod time.Duration) (*RotatingKeysSdkCredentials, error) {
+	return newRotatingKeysSdkCredentials(Previous, PreviousSecretKeyPattern, PreviousPublicKeyPattern, secretKey, publicKey, gracePeriod)
+}
+
+func newRotatingKeysSdkCredentials(
+	platformType PlatformType,
+	secretKeyPattern,
+	publicKeyPattern,
+	secretKey,
+	publicKey string,
+	gracePeriod time.Duration,
+) (*RotatingKeysSdkCredentials, error) {
+	credentials := &RotatingKeysSdkCredentials{
+		GracePeriod:      gracePeriod,
+		Log:              DefaultLogger(),
+		platformType:     platformType,
+		secretKeyPattern: secretKeyPattern,
+		publicKeyPattern: publicKeyPattern,
+	}
+
+	keys := StaticKeys{SecretKey: secretKey, PublicKey: publicKey}
+	if err := credentials.validate(keys); err != nil {
+		return nil, err
+	}
+	credentials.keys = keys
+
+	return credentials, nil
+}
+
+// Keys returns the keys in use.
+func (c *RotatingKeysSdkCredentials) Keys() StaticKeys {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.keys
+}
+
+// Rotate replaces the keys used by the following calls. The keys are validated first and left unchanged when they are
+// invalid.
+func (c *RotatingKeysSdkCredentials) Rotate(secretKey, publicKey string) error {
+	keys := StaticKeys{SecretKey: secretKey, PublicKey: publicKey}
+	if err := c.validate(keys); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if keys == c.keys {
+		return nil
+	}
+	c.previous = c.keys
+	c.keys = keys
+	c.rotatedAt = time.Now()
+	return nil
+}
+
+// Watch loads the keys from source every interval and rotates them when they change, until ctx is done. Errors of
+// the source are logged and the keys in use are kept.
+func (c *RotatingKeysSdkCredentials) Watch(ctx context.Context, interval time.Duration, source KeySource) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		keys, err := source(ctx)
+		if err == nil {
+			err = c.Rotate(keys.SecretKey, keys.PublicKey)
+		}
+		if err != nil && ctx.Err() == nil {
+			c.Log.Printf("error rotating keys: %s", err)
+		}
+	}
+}
+
+func (c *RotatingKeysSdkCredentials) GetAuthorization(authorizationType AuthorizationType) (*SdkAuthorization, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var key, previous string
+	switch authorizationType {
+	case SecretKey, SecretKeyOrOauth:
+		key, previous = c.keys.SecretKey, c.previous.SecretKey
+	case PublicKey, PublicKeyOrOauth:
+		key, previous = c.keys.PublicKey, c.previous.PublicKey
+	default:
+		return nil, errors.CheckoutAuthorizationError("Invalid authorization type")
+	}
+
+	authorization := &SdkAuthorization{PlatformType: c.platformType, Credential: key}
+	if previous != "" && previous != key && time.Since(c.rotatedAt) < c.GracePeriod {
+		authorization.Fallback = &SdkAuthorization{PlatformType: c.platformType, Credential: previous}
+	}
+	return authorization, nil
+}
+
+func (c *RotatingKeysSdkCredentials) validate(keys StaticKeys) error {
+	if !regexp.MustCompile(c.secretKeyPattern).MatchString(keys.SecretKey) {
+		return errors.CheckoutArgumentError("Invalid secret key")
+	}
+	if keys.PublicKey != "" && !regexp.MustCompile(c.publicKeyPattern).MatchString(keys.PublicKey) {
+		return errors.CheckoutArgumentError("Invalid public key")
+	}
+	return nil
+}
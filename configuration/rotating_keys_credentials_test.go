@@ -0,0 +1,91 @@
+package configuration
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	currentSecretKey = "sk_sbox_m73dzbpy7cf3gfd46xr4yj5xo4e"
+	currentPublicKey = "pk_sbox_pkhpdtvmkgf7hdgpwnbhw7r2uic"
+	rotatedSecretKey = "sk_sbox_2zb5qvyk5pjaedrvxfa4ydlzoyq"
+	rotatedPublicKey = "pk_sbox_2zb5qvyk5pjaedrvxfa4ydlzoyq"
+)
+
+func TestRotatingKeysSdkCredentials_RejectsInvalidKeys(t *testing.T) {
+	_, err := NewRotatingKeysSdkCredentials("sk_invalid", currentPublicKey, time.Minute)
+	assert.EqualError(t, err, "Invalid secret key")
+
+	credentials, err := NewRotatingKeysSdkCredentials(currentSecretKey, currentPublicKey, time.Minute)
+	assert.Nil(t, err)
+
+	assert.EqualError(t, credentials.Rotate(rotatedSecretKey, "pk_invalid"), "Invalid public key")
+	assert.Equal(t, StaticKeys{SecretKey: currentSecretKey, PublicKey: currentPublicKey}, credentials.Keys())
+}
+
+func TestRotatingKeysSdkCredentials_RotateWithinGracePeriod(t *testing.T) {
+	credentials, err := NewRotatingKeysSdkCredentials(currentSecretKey, currentPublicKey, time.Minute)
+	assert.Nil(t, err)
+
+	authorization, err := credentials.GetAuthorization(SecretKey)
+	assert.Nil(t, err)
+	assert.Equal(t, currentSecretKey, authorization.Credential)
+	assert.Nil(t, authorization.Fallback)
+
+	assert.Nil(t, credentials.Rotate(rotatedSecretKey, rotatedPublicKey))
+
+	authorization, err = credentials.GetAuthorization(SecretKeyOrOauth)
+	assert.Nil(t, err)
+	assert.Equal(t, Default, authorization.PlatformType)
+	assert.Equal(t, rotatedSecretKey, authorization.Credential)
+	assert.Equal(t, currentSecretKey, authorization.Fallback.Credential)
+
+	authorization, err = credentials.GetAuthorization(PublicKey)
+	assert.Nil(t, err)
+	assert.Equal(t, rotatedPublicKey, authorization.Credential)
+	assert.Equal(t, currentPublicKey, authorization.Fallback.Credential)
+
+	_, err = credentials.GetAuthorization(OAuth)
+	assert.EqualError(t, err, "Invalid authorization type")
+}
+
+func TestRotatingKeysSdkCredentials_NoFallbackAfterGracePeriod(t *testing.T) {
+	credentials, err := NewRotatingKeysSdkCredentials(currentSecretKey, currentPublicKey, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, credentials.Rotate(rotatedSecretKey, rotatedPublicKey))
+
+	authorization, err := credentials.GetAuthorization(SecretKey)
+	assert.Nil(t, err)
+	assert.Equal(t, rotatedSecretKey, authorization.Credential)
+	assert.Nil(t, authorization.Fallback)
+}
+
+func TestRotatingKeysSdkCredentials_Watch(t *testing.T) {
+	credentials, err := NewRotatingKeysSdkCredentials(currentSecretKey, currentPublicKey, time.Minute)
+	assert.Nil(t, err)
+
+	var loads int32
+	source := func(ctx context.Context) (StaticKeys, error) {
+		if atomic.AddInt32(&loads, 1) == 1 {
+			return StaticKeys{}, errors.New("secret manager unavailable")
+		}
+		return StaticKeys{SecretKey: rotatedSecretKey, PublicKey: rotatedPublicKey}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- credentials.Watch(ctx, 10*time.Millisecond, source) }()
+
+	assert.Eventually(t, func() bool {
+		return credentials.Keys().SecretKey == rotatedSecretKey
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	assert.Equal(t, rotatedPublicKey, credentials.Keys().PublicKey)
+}
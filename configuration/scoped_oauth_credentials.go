@@ -0,0 +1,154 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+// ScopedOAuthSdkCredentials requests a separate token for each operation, holding only the scopes the operation
+// requires. Tokens are requested the first time they are needed and cached per set of scopes.
+type ScopedOAuthSdkCredentials struct {
+	HttpClient         *http.Client
+	ClientId           string
+	ClientSecret       string
+	ClientSecretSource SecretSource
+	AuthorizationUri   string
+	// GrantedScopes are the scopes of the client. A scope is also granted when its parent is, "gateway" granting
+	// "gateway:payment-refunds"
+	GrantedScopes []string
+	// OperationScopes maps the operations to the scopes they require, as DefaultOperationScopes does. Operations
+	// without an entry use a token holding every granted scope
+	OperationScopes map[string][]string
+	Log             StdLogger
+	RefreshMargin   time.Duration
+	TokenStore      TokenStore
+
+	mu     sync.Mutex
+	tokens map[string]*OAuthSdkCredentials
+	closed bool
+}
+
+func (f *ScopedOAuthSdkCredentials) GetAuthorization(authorizationType AuthorizationType) (*SdkAuthorization, error) {
+	authorization, err := f.GetAuthorizationWithContext(context.Background(), authorizationType)
+	return authorization, authorizationError(err)
+}
+
+// GetAuthorizationWithContext returns a token for the scopes of the operation recorded in ctx. An
+// errors.CheckoutScopeError is returned when some of them are not granted.
+func (f *ScopedOAuthSdkCredentials) GetAuthorizationWithContext(ctx context.Context, authorizationType AuthorizationType) (*SdkAuthorization, error) {
+	operation := OperationFromContext(ctx)
+	scopes := f.ScopesFor(operation)
+
+	var missing []string
+	for _, scope := range scopes {
+		if !f.isGranted(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.CheckoutScopeError{Operation: operation, Scopes: missing}
+	}
+
+	return f.credentials(scopes).GetAuthorizationWithContext(ctx, authorizationType)
+}
+
+// ScopesFor returns the scopes required by an operation, looked up first by its full name and then by its package.
+// Parent packages are not looked up, their scopes rarely allowing the operations of a subpackage.
+func (f *ScopedOAuthSdkCredentials) ScopesFor(operation string) []string {
+	if scopes, ok := f.OperationScopes[operation]; ok {
+		return scopes
+	}
+
+	if i := strings.LastIndex(operation, "."); i >= 0 {
+		if scopes, ok := f.OperationScopes[operation[:i]]; ok {
+			return scopes
+		}
+	}
+	return f.GrantedScopes
+}
+
+// Validate returns an errors.CheckoutArgumentError naming the scopes of OperationScopes that are not granted, so that
+// a client mapping operations to scopes it was never given fails when it is built rather than on its first call.
+func (f *ScopedOAuthSdkCredentials) Validate() error {
+	missing := map[string]bool{}
+	for _, scopes := range f.OperationScopes {
+		for _, scope := range scopes {
+			if !f.isGranted(scope) {
+				missing[scope] = true
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(missing))
+	for scope := range missing {
+		names = append(names, scope)
+	}
+	sort.Strings(names)
+	return errors.CheckoutArgumentError("OAuth operation scopes are not granted to the client: " + strings.Join(names, ", "))
+}
+
+func (f *ScopedOAuthSdkCredentials) isGranted(scope string) bool {
+	for _, granted := range f.GrantedScopes {
+		if scope == granted || strings.HasPrefix(scope, granted+":") {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *ScopedOAuthSdkCredentials) credentials(scopes []string) *OAuthSdkCredentials {
+	key := TokenStoreKey(f.ClientId, scopes)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if credentials, ok := f.tokens[key]; ok {
+		return credentials
+	}
+
+	logger := f.Log
+	if logger == nil {
+		logger = DefaultLogger()
+	}
+
+	credentials := &OAuthSdkCredentials{
+		HttpClient:         f.HttpClient,
+		ClientId:           f.ClientId,
+		ClientSecret:       f.ClientSecret,
+		ClientSecretSource: f.ClientSecretSource,
+		AuthorizationUri:   f.AuthorizationUri,
+		Scopes:             scopes,
+		Log:                logger,
+		RefreshMargin:      f.RefreshMargin,
+		TokenStore:         f.TokenStore,
+	}
+	if f.closed {
+		_ = credentials.Close()
+	}
+	if f.tokens == nil {
+		f.tokens = make(map[string]*OAuthSdkCredentials)
+	}
+	f.tokens[key] = credentials
+	return credentials
+}
+
+// Close stops renewing the tokens of every set of scopes from a timer, as OAuthSdkCredentials.Close does.
+func (f *ScopedOAuthSdkCredentials) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+	for _, credentials := range f.tokens {
+		_ = credentials.Close()
+	}
+	return nil
+}
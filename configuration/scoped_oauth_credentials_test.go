@@ -0,0 +1,101 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+func TestScopedOAuthSdkCredentials_ScopesFor(t *testing.T) {
+	credentials := &ScopedOAuthSdkCredentials{
+		GrantedScopes:   []string{Gateway, Disputes},
+		OperationScopes: DefaultOperationScopes,
+	}
+
+	assert.Equal(t, []string{GatewayPaymentRefunds}, credentials.ScopesFor("payments.RefundPayment"))
+	assert.Equal(t, []string{Gateway, Disputes}, credentials.ScopesFor("payments.links.CreatePaymentLink"))
+	assert.Equal(t, []string{VaultApmeEnrollment}, credentials.ScopesFor("payments.applepay.EnrollDomain"))
+	assert.Equal(t, []string{PaymentContexts}, credentials.ScopesFor("payments.contexts.RequestPaymentContexts"))
+	assert.Equal(t, []string{Gateway, Disputes}, credentials.ScopesFor("sessions.RequestSession"))
+	assert.Equal(t, []string{Gateway, Disputes}, credentials.ScopesFor(""))
+}
+
+func TestScopedOAuthSdkCredentials_RequestsOneTokenPerScopeSet(t *testing.T) {
+	var mu sync.Mutex
+	requested := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		scope := r.Form.Get("scope")
+
+		mu.Lock()
+		requested[scope]++
+		mu.Unlock()
+		_, _ = fmt.Fprintf(w, `{"access_token":"token %s","token_type":"Bearer","expires_in":3600}`, scope)
+	}))
+	defer server.Close()
+
+	credentials := &ScopedOAuthSdkCredentials{
+		ClientId:         "client_id",
+		ClientSecret:     "client_secret",
+		AuthorizationUri: server.URL,
+		GrantedScopes:    []string{Gateway},
+		OperationScopes:  DefaultOperationScopes,
+		Log:              log.New(ioutil.Discard, "", 0),
+	}
+
+	authorize := func(operation string) string {
+		authorization, err := credentials.GetAuthorizationWithContext(ContextWithOperation(context.Background(), operation), SecretKeyOrOauth)
+		assert.Nil(t, err)
+		return authorization.Credential
+	}
+
+	assert.Equal(t, "token gateway:payment-refunds", authorize("payments.RefundPayment"))
+	assert.Equal(t, "token gateway:payment-refunds", authorize("payments.RefundPayment"))
+	assert.Equal(t, "token gateway:payment-details", authorize("payments.GetPaymentDetails"))
+	assert.Equal(t, "token gateway:payment-details", authorize("payments.GetPaymentActions"))
+	assert.Equal(t, map[string]int{GatewayPaymentRefunds: 1, GatewayPaymentDetails: 1}, requested)
+}
+
+func TestScopedOAuthSdkCredentials_FailsFastWhenScopeNotGranted(t *testing.T) {
+	credentials := &ScopedOAuthSdkCredentials{
+		ClientId:         "client_id",
+		ClientSecret:     "client_secret",
+		AuthorizationUri: "http://localhost:0/unused",
+		GrantedScopes:    []string{GatewayPaymentDetails, Disputes},
+		OperationScopes:  DefaultOperationScopes,
+	}
+
+	_, err := credentials.GetAuthorizationWithContext(ContextWithOperation(context.Background(), "payments.RefundPayment"), SecretKeyOrOauth)
+
+	assert.Equal(t, errors.CheckoutScopeError{Operation: "payments.RefundPayment", Scopes: []string{GatewayPaymentRefunds}}, err)
+	assert.Equal(t, "payments.RefundPayment requires the OAuth scopes gateway:payment-refunds, which are not granted to the client", err.Error())
+}
+
+func TestScopedOAuthSdkCredentials_Validate(t *testing.T) {
+	credentials := &ScopedOAuthSdkCredentials{
+		GrantedScopes: []string{Gateway, DisputesView},
+		OperationScopes: map[string][]string{
+			"payments":               {Gateway},
+			"payments.RefundPayment": {GatewayPaymentRefunds},
+			"disputes":               {DisputesView},
+			"disputes.Accept":        {DisputesAccept},
+			"forward":                {Forward},
+		},
+	}
+
+	err := credentials.Validate()
+
+	assert.Equal(t, errors.CheckoutArgumentError("OAuth operation scopes are not granted to the client: disputes:accept, forward"), err)
+
+	credentials.GrantedScopes = []string{Gateway, Disputes, Forward}
+	assert.Nil(t, credentials.Validate())
+}
@@ -1,6 +1,10 @@
 package configuration
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
 
 type SdkBuilder struct {
 	EnableTelemetry      *bool
@@ -8,8 +12,61 @@ type SdkBuilder struct {
 	EnvironmentSubdomain *EnvironmentSubdomain
 	HttpClient           *http.Client
 	Logger               StdLogger
+	RetryPolicy          *RetryPolicy
+
+	GenerateIdempotencyKeys bool
+	Middlewares             []ClientMiddleware
+	Tracer                  Tracer
+	Metrics                 Metrics
+	RequestLogger           RequestLogger
+	Redactor                *common.Redactor
+	RateLimiter             RateLimiter
+	CircuitBreaker          *CircuitBreakerPolicy
+	Transport               *TransportOptions
 }
 
 func (s *SdkBuilder) GetConfiguration(string, string) *Configuration {
 	return new(Configuration)
 }
+
+// Settings returns the client settings of the builder, so that they can be changed without knowing the concrete
+// builder, as the client pool does to share its HTTP client
+func (s *SdkBuilder) Settings() *SdkBuilder {
+	return s
+}
+
+// TransportOptions returns the transport options of the builder, creating them when needed
+func (s *SdkBuilder) TransportOptions() *TransportOptions {
+	if s.Transport == nil {
+		s.Transport = &TransportOptions{}
+	}
+	return s.Transport
+}
+
+// ResolveHttpClient replaces the HTTP client of the builder with one using the transport options, when there are any.
+// It is called by Build, before the client is given to the configuration and the OAuth credentials
+func (s *SdkBuilder) ResolveHttpClient() error {
+	if s.Transport == nil {
+		return nil
+	}
+
+	client, err := s.Transport.Apply(s.HttpClient)
+	if err != nil {
+		return err
+	}
+	s.HttpClient = client
+	return nil
+}
+
+// ApplyOptions copies the optional client settings held by the builder onto the configuration
+func (s *SdkBuilder) ApplyOptions(configuration *Configuration) {
+	configuration.RetryPolicy = s.RetryPolicy
+	configuration.GenerateIdempotencyKeys = s.GenerateIdempotencyKeys
+	configuration.Middlewares = s.Middlewares
+	configuration.Tracer = s.Tracer
+	configuration.Metrics = s.Metrics
+	configuration.RequestLogger = s.RequestLogger
+	configuration.Redactor = s.Redactor
+	configuration.RateLimiter = s.RateLimiter
+	configuration.CircuitBreaker = s.CircuitBreaker
+}
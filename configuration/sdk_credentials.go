@@ -1,6 +1,10 @@
 package configuration
 
-import "github.com/checkout/checkout-sdk-go/v2/errors"
+import (
+	"context"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
 
 type PlatformType string
 
@@ -27,12 +31,36 @@ type (
 		GetAuthorization(authorizationType AuthorizationType) (*SdkAuthorization, error)
 	}
 
+	// ContextSdkCredentials is implemented by the credentials that call a remote service to resolve an authorization,
+	// so that the call is bound to the context of the operation that needs it
+	ContextSdkCredentials interface {
+		SdkCredentials
+		GetAuthorizationWithContext(ctx context.Context, authorizationType AuthorizationType) (*SdkAuthorization, error)
+	}
+
 	SdkAuthorization struct {
 		PlatformType PlatformType
 		Credential   string
+		// Identity names the credential across its renewals, such as the client id of an OAuth token. The credential
+		// identifies itself when it is empty
+		Identity string
+		// Fallback is sent once more in place of this authorization when the API rejects it with a 401
+		Fallback *SdkAuthorization
 	}
 )
 
+type operationKey struct{}
+
+// ContextWithOperation records the operation, such as "payments.RefundPayment", for which an authorization is resolved.
+func ContextWithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+func OperationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
+
 func (s *SdkAuthorization) GetAuthorizationHeader() (string, error) {
 	switch s.PlatformType {
 	case Previous, Custom:
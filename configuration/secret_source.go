@@ -0,0 +1,142 @@
+package configuration
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+// DefaultSecretReloadInterval is how often a ReloadingFileSecretSource checks its file when no interval is given
+const DefaultSecretReloadInterval = 10 * time.Second
+
+type (
+	// SecretSource resolves a secret, such as a secret key or an OAuth client secret, when the SDK needs it.
+	// Implementations are called concurrently and should be cheap, caching the secret when resolving it is not
+	SecretSource interface {
+		Secret(ctx context.Context) (string, error)
+	}
+
+	// SecretSourceFunc adapts an ordinary function to the SecretSource interface
+	SecretSourceFunc func(ctx context.Context) (string, error)
+)
+
+func (f SecretSourceFunc) Secret(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// EnvSecretSource reads the secret from an environment variable every time it is needed.
+type EnvSecretSource struct {
+	Name string
+}
+
+func NewEnvSecretSource(name string) *EnvSecretSource {
+	return &EnvSecretSource{Name: name}
+}
+
+func (s *EnvSecretSource) Secret(context.Context) (string, error) {
+	secret := strings.TrimSpace(os.Getenv(s.Name))
+	if secret == "" {
+		return "", errors.CheckoutArgumentError("Environment variable " + s.Name + " is not set")
+	}
+	return secret, nil
+}
+
+// FileSecretSource reads the secret from a file the first time it is needed and keeps it for the lifetime of the
+// source. Surrounding whitespace, such as a trailing new line, is removed.
+type FileSecretSource struct {
+	Path string
+
+	mu     sync.Mutex
+	secret string
+}
+
+func NewFileSecretSource(path string) *FileSecretSource {
+	return &FileSecretSource{Path: path}
+}
+
+func (s *FileSecretSource) Secret(context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.secret == "" {
+		secret, err := readSecretFile(s.Path)
+		if err != nil {
+			return "", err
+		}
+		s.secret = secret
+	}
+	return s.secret, nil
+}
+
+// ReloadingFileSecretSource reads the secret from a file and reads it again when the file changes, so that a secret
+// rotated on disk, for example by a sidecar, is used without a restart. The file is checked at most once per
+// Interval. The last secret read is kept while the file is missing or empty, as it can be while being replaced, and
+// the file is not checked again before the following Interval.
+type ReloadingFileSecretSource struct {
+	Path     string
+	Interval time.Duration
+
+	mu        sync.Mutex
+	secret    string
+	modTime   time.Time
+	size      int64
+	checkedAt time.Time
+}
+
+func NewReloadingFileSecretSource(path string, interval time.Duration) *ReloadingFileSecretSource {
+	return &ReloadingFileSecretSource{Path: path, Interval: interval}
+}
+
+func (s *ReloadingFileSecretSource) Secret(context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultSecretReloadInterval
+	}
+	if s.secret != "" && time.Since(s.checkedAt) < interval {
+		return s.secret, nil
+	}
+
+	err := s.reload()
+	if s.secret == "" {
+		return "", err
+	}
+	return s.secret, nil
+}
+
+func (s *ReloadingFileSecretSource) reload() error {
+	s.checkedAt = time.Now()
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return err
+	}
+	if s.secret != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return nil
+	}
+
+	secret, err := readSecretFile(s.Path)
+	if err != nil {
+		return err
+	}
+	s.secret, s.modTime, s.size = secret, info.ModTime(), info.Size()
+	return nil
+}
+
+func readSecretFile(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", errors.CheckoutArgumentError("Secret file " + path + " is empty")
+	}
+	return secret, nil
+}
@@ -0,0 +1,79 @@
+package configuration
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+// SecretSourceSdkCredentials resolves the static keys from secret sources when a call needs them, instead of holding
+// them from construction. A key is validated every time it is resolved, so that a malformed rotation fails the calls
+// instead of reaching the API.
+type SecretSourceSdkCredentials struct {
+	SecretKey SecretSource
+	// PublicKey is only required by the operations authorized with the public key
+	PublicKey SecretSource
+
+	platformType     PlatformType
+	secretKeyPattern *regexp.Regexp
+	publicKeyPattern *regexp.Regexp
+}
+
+func NewSecretSourceSdkCredentials(secretKey, publicKey SecretSource) *SecretSourceSdkCredentials {
+	return newSecretSourceSdkCredentials(Default, DefaultSecretKeyPattern, DefaultPublicKeyPattern, secretKey, publicKey)
+}
+
+func NewSecretSourcePreviousSdkCredentials(secretKey, publicKey SecretSource) *SecretSourceSdkCredentials {
+	return newSecretSourceSdkCredentials(Previous, PreviousSecretKeyPattern, PreviousPublicKeyPattern, secretKey, publicKey)
+}
+
+func newSecretSourceSdkCredentials(
+	platformType PlatformType,
+	secretKeyPattern,
+	publicKeyPattern string,
+	secretKey,
+	publicKey SecretSource,
+) *SecretSourceSdkCredentials {
+	return &SecretSourceSdkCredentials{
+		SecretKey:        secretKey,
+		PublicKey:        publicKey,
+		platformType:     platformType,
+		secretKeyPattern: regexp.MustCompile(secretKeyPattern),
+		publicKeyPattern: regexp.MustCompile(publicKeyPattern),
+	}
+}
+
+func (f *SecretSourceSdkCredentials) GetAuthorization(authorizationType AuthorizationType) (*SdkAuthorization, error) {
+	return f.GetAuthorizationWithContext(context.Background(), authorizationType)
+}
+
+func (f *SecretSourceSdkCredentials) GetAuthorizationWithContext(ctx context.Context, authorizationType AuthorizationType) (*SdkAuthorization, error) {
+	var source SecretSource
+	var pattern *regexp.Regexp
+	var name string
+	switch authorizationType {
+	case SecretKey, SecretKeyOrOauth:
+		source, pattern, name = f.SecretKey, f.secretKeyPattern, "secret key"
+	case PublicKey, PublicKeyOrOauth:
+		source, pattern, name = f.PublicKey, f.publicKeyPattern, "public key"
+	default:
+		return nil, errors.CheckoutAuthorizationError("Invalid authorization type")
+	}
+
+	if source == nil {
+		return nil, errors.CheckoutAuthorizationError("No source for the " + name)
+	}
+	key, err := source.Secret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !pattern.MatchString(key) {
+		return nil, errors.CheckoutArgumentError("Invalid " + name)
+	}
+
+	return &SdkAuthorization{
+		PlatformType: f.platformType,
+		Credential:   key,
+	}, nil
+}
@@ -0,0 +1,156 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeSecretFile(t *testing.T, path, secret string, modTime time.Time) {
+	if err := ioutil.WriteFile(path, []byte(secret+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func secretDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func TestEnvSecretSource(t *testing.T) {
+	source := NewEnvSecretSource("CKO_TEST_SECRET_SOURCE")
+
+	_, err := source.Secret(context.Background())
+	assert.EqualError(t, err, "Environment variable CKO_TEST_SECRET_SOURCE is not set")
+
+	_ = os.Setenv("CKO_TEST_SECRET_SOURCE", currentSecretKey)
+	defer os.Unsetenv("CKO_TEST_SECRET_SOURCE")
+
+	secret, err := source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, currentSecretKey, secret)
+}
+
+func TestFileSecretSource_ReadsOnce(t *testing.T) {
+	path := filepath.Join(secretDir(t), "secret_key")
+	writeSecretFile(t, path, currentSecretKey, time.Now())
+	source := NewFileSecretSource(path)
+
+	secret, err := source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, currentSecretKey, secret)
+
+	writeSecretFile(t, path, rotatedSecretKey, time.Now().Add(time.Minute))
+	secret, _ = source.Secret(context.Background())
+	assert.Equal(t, currentSecretKey, secret)
+}
+
+func TestReloadingFileSecretSource_PicksUpRotation(t *testing.T) {
+	path := filepath.Join(secretDir(t), "secret_key")
+	writeSecretFile(t, path, currentSecretKey, time.Now().Add(-time.Minute))
+	source := NewReloadingFileSecretSource(path, time.Nanosecond)
+
+	secret, err := source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, currentSecretKey, secret)
+
+	writeSecretFile(t, path, rotatedSecretKey, time.Now())
+	secret, err = source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, rotatedSecretKey, secret)
+
+	assert.Nil(t, os.Remove(path))
+	secret, err = source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, rotatedSecretKey, secret)
+}
+
+func TestReloadingFileSecretSource_FailedReloadWaitsForInterval(t *testing.T) {
+	path := filepath.Join(secretDir(t), "secret_key")
+	writeSecretFile(t, path, currentSecretKey, time.Now().Add(-time.Minute))
+	source := NewReloadingFileSecretSource(path, time.Hour)
+
+	secret, err := source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, currentSecretKey, secret)
+
+	assert.Nil(t, os.Remove(path))
+	source.checkedAt = time.Now().Add(-2 * time.Hour)
+	secret, err = source.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, currentSecretKey, secret)
+	assert.WithinDuration(t, time.Now(), source.checkedAt, time.Minute)
+
+	writeSecretFile(t, path, rotatedSecretKey, time.Now())
+	secret, _ = source.Secret(context.Background())
+	assert.Equal(t, currentSecretKey, secret, "the file is not checked again before the interval")
+
+	source.checkedAt = time.Now().Add(-2 * time.Hour)
+	secret, _ = source.Secret(context.Background())
+	assert.Equal(t, rotatedSecretKey, secret)
+}
+
+func TestReloadingFileSecretSource_MissingFile(t *testing.T) {
+	source := NewReloadingFileSecretSource(filepath.Join(secretDir(t), "secret_key"), 0)
+
+	_, err := source.Secret(context.Background())
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSecretSourceSdkCredentials(t *testing.T) {
+	secretKey := currentSecretKey
+	credentials := NewSecretSourceSdkCredentials(SecretSourceFunc(func(context.Context) (string, error) {
+		return secretKey, nil
+	}), nil)
+
+	authorization, err := credentials.GetAuthorization(SecretKeyOrOauth)
+	assert.Nil(t, err)
+	assert.Equal(t, Default, authorization.PlatformType)
+	assert.Equal(t, currentSecretKey, authorization.Credential)
+
+	secretKey = rotatedSecretKey
+	authorization, err = credentials.GetAuthorization(SecretKey)
+	assert.Nil(t, err)
+	assert.Equal(t, rotatedSecretKey, authorization.Credential)
+
+	secretKey = "sk_invalid"
+	_, err = credentials.GetAuthorization(SecretKey)
+	assert.EqualError(t, err, "Invalid secret key")
+
+	_, err = credentials.GetAuthorization(PublicKey)
+	assert.EqualError(t, err, "No source for the public key")
+}
+
+func TestOAuthSdkCredentials_ResolvesClientSecretSource(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		received = r.PostForm.Get("client_secret")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+	}))
+	defer server.Close()
+
+	credentials := newOAuthCredentials(server.URL, nil)
+	credentials.ClientSecretSource = SecretSourceFunc(func(context.Context) (string, error) {
+		return "rotated_client_secret", nil
+	})
+
+	assert.Nil(t, credentials.GetAccessToken())
+	assert.Equal(t, "rotated_client_secret", received)
+}
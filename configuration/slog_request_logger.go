@@ -0,0 +1,105 @@
+//go:build go1.21
+
+package configuration
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sort"
+)
+
+type (
+	// SlogRequestLogger writes the request and response records to a log/slog logger. Requests are logged
+	// at debug level, successful responses at info level, client errors at warn level and everything else at error level.
+	SlogRequestLogger struct {
+		logger  *slog.Logger
+		options SlogOptions
+	}
+
+	SlogOptions struct {
+		// LogHeaders adds the redacted request and response headers to the records
+		LogHeaders bool
+		// LogBodies adds the redacted request and response bodies to the records
+		LogBodies bool
+	}
+)
+
+func NewSlogRequestLogger(logger *slog.Logger, options SlogOptions) *SlogRequestLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogRequestLogger{logger: logger, options: options}
+}
+
+func (l *SlogRequestLogger) LogsBodies() bool {
+	return l.options.LogBodies
+}
+
+func (l *SlogRequestLogger) LogRequest(ctx context.Context, record *RequestLogRecord) {
+	if !l.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", record.Operation),
+		slog.String("method", record.Method),
+		slog.String("path", record.Path),
+	}
+	attrs = l.appendPayload(attrs, record.Headers, record.Body)
+
+	l.logger.LogAttrs(ctx, slog.LevelDebug, "checkout request", attrs...)
+}
+
+func (l *SlogRequestLogger) LogResponse(ctx context.Context, record *ResponseLogRecord) {
+	level := responseLevel(record)
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", record.Operation),
+		slog.String("method", record.Method),
+		slog.String("path", record.Path),
+		slog.Int("status", record.StatusCode),
+		slog.String("request_id", record.RequestId),
+		slog.Duration("duration", record.Duration),
+	}
+	if record.Err != nil {
+		attrs = append(attrs, slog.String("error", record.Err.Error()))
+	}
+	attrs = l.appendPayload(attrs, record.Headers, record.Body)
+
+	l.logger.LogAttrs(ctx, level, "checkout response", attrs...)
+}
+
+func (l *SlogRequestLogger) appendPayload(attrs []slog.Attr, headers http.Header, body []byte) []slog.Attr {
+	if l.options.LogHeaders && len(headers) > 0 {
+		keys := make([]string, 0, len(headers))
+		for key := range headers {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		values := make([]any, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, slog.String(key, headers.Get(key)))
+		}
+		attrs = append(attrs, slog.Group("headers", values...))
+	}
+	if l.options.LogBodies && len(body) > 0 {
+		attrs = append(attrs, slog.String("body", string(body)))
+	}
+	return attrs
+}
+
+func responseLevel(record *ResponseLogRecord) slog.Level {
+	switch {
+	case record.StatusCode >= http.StatusInternalServerError || (record.Err != nil && record.StatusCode == 0):
+		return slog.LevelError
+	case record.StatusCode >= http.StatusBadRequest:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
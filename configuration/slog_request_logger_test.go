@@ -0,0 +1,62 @@
+//go:build go1.21
+
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBufferLogger(level slog.Level) (*slog.Logger, *bytes.Buffer) {
+	var buffer bytes.Buffer
+	return slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: level})), &buffer
+}
+
+func TestSlogRequestLogger_LogsResponseFields(t *testing.T) {
+	logger, buffer := newBufferLogger(slog.LevelInfo)
+	requestLogger := NewSlogRequestLogger(logger, SlogOptions{})
+
+	requestLogger.LogRequest(context.Background(), &RequestLogRecord{Method: http.MethodGet, Path: "/payments"})
+	requestLogger.LogResponse(context.Background(), &ResponseLogRecord{
+		Operation:  "payments.GetPaymentDetails",
+		Method:     http.MethodGet,
+		Path:       "/payments",
+		StatusCode: http.StatusOK,
+		RequestId:  "req-1",
+		Duration:   25 * time.Millisecond,
+		Body:       []byte(`{"id":"pay_1"}`),
+	})
+
+	output := buffer.String()
+	assert.NotContains(t, output, "checkout request", "requests are logged at debug level")
+	assert.Contains(t, output, "level=INFO msg=\"checkout response\" operation=payments.GetPaymentDetails method=GET path=/payments status=200 request_id=req-1 duration=25ms")
+	assert.NotContains(t, output, "body=")
+}
+
+func TestSlogRequestLogger_LogsHeadersAndBodiesWhenEnabled(t *testing.T) {
+	logger, buffer := newBufferLogger(slog.LevelDebug)
+	requestLogger := NewSlogRequestLogger(logger, SlogOptions{LogHeaders: true, LogBodies: true})
+
+	requestLogger.LogRequest(context.Background(), &RequestLogRecord{
+		Method:  http.MethodPost,
+		Path:    "/payments",
+		Headers: http.Header{"Authorization": []string{"[REDACTED]"}},
+		Body:    []byte(`{"amount":100}`),
+	})
+
+	assert.Contains(t, buffer.String(), `headers.Authorization=[REDACTED] body="{\"amount\":100}"`)
+}
+
+func TestSlogRequestLogger_ResponseLevels(t *testing.T) {
+	assert.Equal(t, slog.LevelInfo, responseLevel(&ResponseLogRecord{StatusCode: http.StatusCreated}))
+	assert.Equal(t, slog.LevelWarn, responseLevel(&ResponseLogRecord{StatusCode: http.StatusUnprocessableEntity}))
+	assert.Equal(t, slog.LevelError, responseLevel(&ResponseLogRecord{StatusCode: http.StatusBadGateway}))
+	assert.Equal(t, slog.LevelError, responseLevel(&ResponseLogRecord{Err: errors.New("connection reset")}))
+}
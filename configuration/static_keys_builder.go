@@ -8,8 +8,11 @@ import (
 
 type StaticKeysBuilder struct {
 	SdkBuilder
-	PublicKey string
-	SecretKey string
+	PublicKey       string
+	SecretKey       string
+	RotatingKeys    *RotatingKeysSdkCredentials
+	SecretKeySource SecretSource
+	PublicKeySource SecretSource
 }
 
 func (s *StaticKeysBuilder) ValidateSecretKey(regex string) error {
@@ -32,3 +35,32 @@ func (s *StaticKeysBuilder) ValidatePublicKey(regex string) error {
 
 	return nil
 }
+
+// Credentials returns the rotating keys or the secret sources when they are set, or the static keys once validated
+// against the patterns of the platform.
+func (s *StaticKeysBuilder) Credentials(
+	platformType PlatformType,
+	secretKeyPattern,
+	publicKeyPattern string,
+	static func(secretKey, publicKey string) SdkCredentials,
+) (SdkCredentials, error) {
+	if s.RotatingKeys != nil {
+		if s.RotatingKeys.platformType != platformType {
+			return nil, errors.CheckoutArgumentError("Rotating keys do not belong to the platform of the builder")
+		}
+		return s.RotatingKeys, nil
+	}
+
+	if s.SecretKeySource != nil {
+		return newSecretSourceSdkCredentials(platformType, secretKeyPattern, publicKeyPattern, s.SecretKeySource, s.PublicKeySource), nil
+	}
+
+	if err := s.ValidateSecretKey(secretKeyPattern); err != nil {
+		return nil, err
+	}
+	if err := s.ValidatePublicKey(publicKeyPattern); err != nil {
+		return nil, err
+	}
+
+	return static(s.SecretKey, s.PublicKey), nil
+}
@@ -0,0 +1,132 @@
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TokenStore keeps the OAuth access tokens outside of the credentials, so that several instances of an application
+// can share a token instead of each requesting its own. Get returns nil without an error when there is no token for
+// the key. Implementations must be safe for concurrent use.
+type TokenStore interface {
+	Get(ctx context.Context, key string) (*OAuthAccessToken, error)
+	Set(ctx context.Context, key string, token *OAuthAccessToken) error
+}
+
+// TokenStoreKey identifies the tokens issued to a client for a set of scopes. The order of the scopes does not matter.
+func TokenStoreKey(clientId string, scopes []string) string {
+	sorted := append([]string(nil), scopes...)
+	sort.Strings(sorted)
+	return clientId + ":" + strings.Join(sorted, " ")
+}
+
+type InMemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]OAuthAccessToken
+}
+
+func NewInMemoryTokenStore() *InMemoryTokenStore {
+	return &InMemoryTokenStore{tokens: make(map[string]OAuthAccessToken)}
+}
+
+func (s *InMemoryTokenStore) Get(_ context.Context, key string) (*OAuthAccessToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (s *InMemoryTokenStore) Set(_ context.Context, key string, token *OAuthAccessToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[key] = *token
+	return nil
+}
+
+// FileTokenStore keeps the tokens in a JSON file readable by the owner only, for example on a volume shared by the
+// instances of an application. The file is replaced atomically on every write, so a reader never sees it partially
+// written, but concurrent writers from different processes may overwrite each other's tokens.
+type FileTokenStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+func (s *FileTokenStore) Get(_ context.Context, key string) (*OAuthAccessToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	token, ok := tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (s *FileTokenStore) Set(_ context.Context, key string, token *OAuthAccessToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.read()
+	if err != nil {
+		return err
+	}
+	tokens[key] = *token
+
+	content, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.Path)
+}
+
+func (s *FileTokenStore) read() (map[string]OAuthAccessToken, error) {
+	tokens := make(map[string]OAuthAccessToken)
+
+	content, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(content, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
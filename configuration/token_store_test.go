@@ -0,0 +1,94 @@
+package configuration
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenStoreKey(t *testing.T) {
+	assert.Equal(t, "client_id:files gateway", TokenStoreKey("client_id", []string{Gateway, Files}))
+	assert.Equal(t, TokenStoreKey("client_id", []string{Files, Gateway}), TokenStoreKey("client_id", []string{Gateway, Files}))
+	assert.NotEqual(t, TokenStoreKey("client_id", []string{Files}), TokenStoreKey("other_id", []string{Files}))
+}
+
+func TestTokenStores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token-store")
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	stores := map[string]TokenStore{
+		"memory": NewInMemoryTokenStore(),
+		"file":   NewFileTokenStore(filepath.Join(dir, "tokens.json")),
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			token, err := store.Get(ctx, "client_id:gateway")
+			assert.Nil(t, err)
+			assert.Nil(t, token)
+
+			expiration := time.Now().Add(time.Hour).Round(time.Second)
+			assert.Nil(t, store.Set(ctx, "client_id:gateway", &OAuthAccessToken{Token: "token", ExpirationDate: expiration}))
+			assert.Nil(t, store.Set(ctx, "client_id:files", &OAuthAccessToken{Token: "other", ExpirationDate: expiration}))
+
+			token, err = store.Get(ctx, "client_id:gateway")
+			assert.Nil(t, err)
+			assert.Equal(t, "token", token.Token)
+			assert.True(t, expiration.Equal(token.ExpirationDate))
+		})
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "tokens.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestOAuthSdkCredentials_SharesTokenThroughStore(t *testing.T) {
+	var requests int32
+	server := tokenServer(&requests, 3600, 0)
+	defer server.Close()
+
+	store := NewInMemoryTokenStore()
+	first := newOAuthCredentials(server.URL, nil)
+	first.TokenStore = store
+	second := newOAuthCredentials(server.URL, nil)
+	second.TokenStore = store
+
+	firstAuthorization, err := first.GetAuthorization(OAuth)
+	assert.Nil(t, err)
+	secondAuthorization, err := second.GetAuthorization(OAuth)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "token_1", firstAuthorization.Credential)
+	assert.Equal(t, "token_1", secondAuthorization.Credential)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestOAuthSdkCredentials_IgnoresExpiringStoredToken(t *testing.T) {
+	var requests int32
+	server := tokenServer(&requests, 3600, 0)
+	defer server.Close()
+
+	store := NewInMemoryTokenStore()
+	key := TokenStoreKey("client_id", []string{Gateway})
+	assert.Nil(t, store.Set(context.Background(), key, &OAuthAccessToken{Token: "expiring", ExpirationDate: time.Now().Add(time.Second)}))
+
+	credentials := newOAuthCredentials(server.URL, nil)
+	credentials.TokenStore = store
+
+	authorization, err := credentials.GetAuthorization(OAuth)
+	assert.Nil(t, err)
+	assert.Equal(t, "token_1", authorization.Credential)
+
+	stored, err := store.Get(context.Background(), key)
+	assert.Nil(t, err)
+	assert.Equal(t, "token_1", stored.Token)
+}
@@ -0,0 +1,23 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	// Tracer creates a span for every SDK operation. The SDK does not depend on any tracing library,
+	// an OpenTelemetry implementation is available in the github.com/checkout/checkout-sdk-go/opentelemetry module
+	Tracer interface {
+		// Start opens a span named after the operation and returns a context carrying it
+		Start(ctx context.Context, operation string) (context.Context, Span)
+		// Inject writes the trace context held by ctx to the headers of the outgoing request
+		Inject(ctx context.Context, header http.Header)
+	}
+
+	Span interface {
+		SetAttribute(key string, value interface{})
+		RecordError(err error)
+		End()
+	}
+)
@@ -0,0 +1,88 @@
+package configuration
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+// TransportOptions secure the connections of the SDK. They apply to the API, the Files, Transfers and Balances
+// APIs, and the OAuth token endpoint alike.
+type TransportOptions struct {
+	// Proxy sends every request through an HTTP proxy. The proxy of the HTTP client, by default the one of the
+	// HTTP_PROXY and HTTPS_PROXY environment variables, is kept when it is nil
+	Proxy *url.URL
+	// RootCAs are the only certificate authorities trusted to sign the certificates of the servers. The system pool
+	// is used when it is nil
+	RootCAs *x509.CertPool
+	// Certificates are presented to the servers requesting a client certificate
+	Certificates []tls.Certificate
+	// MinTLSVersion is the minimum version of TLS accepted, such as tls.VersionTLS12. The default of Go is used
+	// when it is zero
+	MinTLSVersion uint16
+}
+
+// Apply returns a copy of the client whose transport uses the options. The default client of the SDK is used when
+// client is nil. The transport of the client must be an *http.Transport, or nil for the default transport.
+func (o *TransportOptions) Apply(client *http.Client) (*http.Client, error) {
+	if client == nil {
+		client = common.BuildDefaultClient()
+	}
+
+	var transport *http.Transport
+	switch t := client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, errors.CheckoutArgumentError("Transport options require an HTTP client using an *http.Transport")
+	}
+
+	if o.MinTLSVersion != 0 && (o.MinTLSVersion < tls.VersionTLS10 || o.MinTLSVersion > tls.VersionTLS13) {
+		return nil, errors.CheckoutArgumentError("Invalid minimum TLS version")
+	}
+
+	tlsConfig := &tls.Config{}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+	if o.RootCAs != nil {
+		tlsConfig.RootCAs = o.RootCAs
+	}
+	if len(o.Certificates) > 0 {
+		tlsConfig.Certificates = append([]tls.Certificate(nil), o.Certificates...)
+	}
+	if o.MinTLSVersion != 0 {
+		tlsConfig.MinVersion = o.MinTLSVersion
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if o.Proxy != nil {
+		transport.Proxy = http.ProxyURL(o.Proxy)
+	}
+
+	configured := *client
+	configured.Transport = transport
+	return &configured, nil
+}
+
+// LoadRootCAs reads a pool of certificate authorities from PEM encoded files, for example a pinned CA bundle
+func LoadRootCAs(paths ...string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, path := range paths {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.CheckoutArgumentError("No certificate found in " + path)
+		}
+	}
+	return pool, nil
+}
@@ -0,0 +1,79 @@
+package configuration
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type plainRoundTripper struct{}
+
+func (plainRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, nil
+}
+
+func TestTransportOptions_Apply(t *testing.T) {
+	proxyUrl, _ := url.Parse("http://proxy.internal:3128")
+	pool := x509.NewCertPool()
+	certificate := tls.Certificate{Certificate: [][]byte{{1}}}
+	base := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{ServerName: "api.checkout.com"}}}
+
+	options := &TransportOptions{Proxy: proxyUrl, RootCAs: pool, Certificates: []tls.Certificate{certificate}, MinTLSVersion: tls.VersionTLS13}
+	client, err := options.Apply(base)
+	assert.Nil(t, err)
+
+	transport := client.Transport.(*http.Transport)
+	assert.Same(t, pool, transport.TLSClientConfig.RootCAs)
+	assert.Equal(t, []tls.Certificate{certificate}, transport.TLSClientConfig.Certificates)
+	assert.Equal(t, uint16(tls.VersionTLS13), transport.TLSClientConfig.MinVersion)
+	assert.Equal(t, "api.checkout.com", transport.TLSClientConfig.ServerName)
+
+	proxy, err := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "api.checkout.com"}})
+	assert.Nil(t, err)
+	assert.Equal(t, proxyUrl, proxy)
+
+	assert.Nil(t, base.Transport.(*http.Transport).TLSClientConfig.RootCAs)
+	assert.Nil(t, base.Transport.(*http.Transport).Proxy)
+}
+
+func TestTransportOptions_ApplyDefaultClient(t *testing.T) {
+	client, err := (&TransportOptions{MinTLSVersion: tls.VersionTLS12}).Apply(nil)
+
+	assert.Nil(t, err)
+	assert.NotZero(t, client.Timeout)
+	assert.Equal(t, uint16(tls.VersionTLS12), client.Transport.(*http.Transport).TLSClientConfig.MinVersion)
+}
+
+func TestTransportOptions_ApplyRejectsInvalidSettings(t *testing.T) {
+	_, err := (&TransportOptions{}).Apply(&http.Client{Transport: plainRoundTripper{}})
+	assert.EqualError(t, err, "Transport options require an HTTP client using an *http.Transport")
+
+	_, err = (&TransportOptions{MinTLSVersion: 0x0200}).Apply(nil)
+	assert.EqualError(t, err, "Invalid minimum TLS version")
+}
+
+func TestLoadRootCAs(t *testing.T) {
+	dir := secretDir(t)
+	path := filepath.Join(dir, "ca.pem")
+	empty := filepath.Join(dir, "empty.pem")
+	assert.Nil(t, ioutil.WriteFile(empty, []byte("not a certificate"), 0600))
+
+	server := newTLSTokenServer(t, nil)
+	defer server.Close()
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.Nil(t, ioutil.WriteFile(path, certificate, 0600))
+
+	pool, err := LoadRootCAs(path)
+	assert.Nil(t, err)
+	assert.NotNil(t, pool)
+
+	_, err = LoadRootCAs(path, empty)
+	assert.EqualError(t, err, "No certificate found in "+empty)
+}
@@ -23,8 +23,9 @@ func (c *Client) Create(request CustomerRequest) (*common.IdResponse, error) {
 	return c.CreateWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateWithContext(ctx context.Context, request CustomerRequest) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateWithContext(ctx context.Context, request CustomerRequest, opts ...client.RequestOption) (*common.IdResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,9 @@ func (c *Client) Get(customerId string) (*GetCustomerResponse, error) {
 	return c.GetWithContext(context.Background(), customerId)
 }
 
-func (c *Client) GetWithContext(ctx context.Context, customerId string) (*GetCustomerResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetWithContext(ctx context.Context, customerId string, opts ...client.RequestOption) (*GetCustomerResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +63,9 @@ func (c *Client) Update(customerId string, request CustomerRequest) (*common.Met
 	return c.UpdateWithContext(context.Background(), customerId, request)
 }
 
-func (c *Client) UpdateWithContext(ctx context.Context, customerId string, request CustomerRequest) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) UpdateWithContext(ctx context.Context, customerId string, request CustomerRequest, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +83,9 @@ func (c *Client) Delete(customerId string) (*common.MetadataResponse, error) {
 	return c.DeleteWithContext(context.Background(), customerId)
 }
 
-func (c *Client) DeleteWithContext(ctx context.Context, customerId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) DeleteWithContext(ctx context.Context, customerId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
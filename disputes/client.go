@@ -23,8 +23,9 @@ func (c *Client) Query(queryFilter QueryFilter) (*QueryResponse, error) {
 	return c.QueryWithContext(context.Background(), queryFilter)
 }
 
-func (c *Client) QueryWithContext(ctx context.Context, queryFilter QueryFilter) (*QueryResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) QueryWithContext(ctx context.Context, queryFilter QueryFilter, opts ...client.RequestOption) (*QueryResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +48,9 @@ func (c *Client) GetDisputeDetails(disputeId string) (*DisputeResponse, error) {
 	return c.GetDisputeDetailsWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) GetDisputeDetailsWithContext(ctx context.Context, disputeId string) (*DisputeResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetDisputeDetailsWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*DisputeResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +68,9 @@ func (c *Client) Accept(disputeId string) (*common.MetadataResponse, error) {
 	return c.AcceptWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) AcceptWithContext(ctx context.Context, disputeId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) AcceptWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +95,9 @@ func (c *Client) PutEvidence(disputeId string, evidenceRequest Evidence) (*commo
 	return c.PutEvidenceWithContext(context.Background(), disputeId, evidenceRequest)
 }
 
-func (c *Client) PutEvidenceWithContext(ctx context.Context, disputeId string, evidenceRequest Evidence) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) PutEvidenceWithContext(ctx context.Context, disputeId string, evidenceRequest Evidence, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +122,9 @@ func (c *Client) GetEvidence(disputeId string) (*EvidenceResponse, error) {
 	return c.GetEvidenceWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) GetEvidenceWithContext(ctx context.Context, disputeId string) (*EvidenceResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetEvidenceWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*EvidenceResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -137,8 +142,9 @@ func (c *Client) SubmitEvidence(disputeId string) (*common.MetadataResponse, err
 	return c.SubmitEvidenceWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) SubmitEvidenceWithContext(ctx context.Context, disputeId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) SubmitEvidenceWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -163,8 +169,9 @@ func (c *Client) GetCompiledSubmittedEvidence(disputeId string) (*DisputeCompile
 	return c.GetCompiledSubmittedEvidenceWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) GetCompiledSubmittedEvidenceWithContext(ctx context.Context, disputeId string) (*DisputeCompiledSubmittedEvidenceResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetCompiledSubmittedEvidenceWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*DisputeCompiledSubmittedEvidenceResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -182,8 +189,34 @@ func (c *Client) UploadFile(file common.File) (*common.IdResponse, error) {
 	return c.UploadFileWithContext(context.Background(), file)
 }
 
-func (c *Client) UploadFileWithContext(ctx context.Context, file common.File) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) UploadFileWithContext(ctx context.Context, file common.File, opts ...client.RequestOption) (*common.IdResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := common.BuildFileUploadRequest(&file)
+	if err != nil {
+		return nil, err
+	}
+
+	var response common.IdResponse
+	err = c.apiClient.UploadWithContext(ctx, common.BuildPath(files), auth, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) UploadFileStream(file common.FileStream) (*common.IdResponse, error) {
+	return c.UploadFileStreamWithContext(context.Background(), file)
+}
+
+func (c *Client) UploadFileStreamWithContext(ctx context.Context, file common.FileStream, opts ...client.RequestOption) (*common.IdResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -206,8 +239,9 @@ func (c *Client) GetFileDetails(fileId string) (*common.FileResponse, error) {
 	return c.GetFileDetailsWithContext(context.Background(), fileId)
 }
 
-func (c *Client) GetFileDetailsWithContext(ctx context.Context, fileId string) (*common.FileResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetFileDetailsWithContext(ctx context.Context, fileId string, opts ...client.RequestOption) (*common.FileResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -225,8 +259,9 @@ func (c *Client) SubmitArbitrationEvidence(disputeId string) (*common.MetadataRe
 	return c.SubmitArbitrationEvidenceWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) SubmitArbitrationEvidenceWithContext(ctx context.Context, disputeId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) SubmitArbitrationEvidenceWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -251,8 +286,9 @@ func (c *Client) GetSubmittedArbitrationEvidence(disputeId string) (*DisputeComp
 	return c.GetSubmittedArbitrationEvidenceWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) GetSubmittedArbitrationEvidenceWithContext(ctx context.Context, disputeId string) (*DisputeCompiledSubmittedEvidenceResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetSubmittedArbitrationEvidenceWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*DisputeCompiledSubmittedEvidenceResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -270,8 +306,9 @@ func (c *Client) GetDisputeSchemeFiles(disputeId string) (*SchemeFilesResponse,
 	return c.GetDisputeSchemeFilesWithContext(context.Background(), disputeId)
 }
 
-func (c *Client) GetDisputeSchemeFilesWithContext(ctx context.Context, disputeId string) (*SchemeFilesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetDisputeSchemeFilesWithContext(ctx context.Context, disputeId string, opts ...client.RequestOption) (*SchemeFilesResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package disputes
 
 import (
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -1046,3 +1047,100 @@ func TestGetSubmittedArbitrationEvidence(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadFileStream(t *testing.T) {
+	var (
+		response = common.IdResponse{
+			HttpMetadata: mocks.HttpMetadataStatusOk,
+			Id:           "file_123",
+		}
+	)
+
+	cases := []struct {
+		name             string
+		file             common.FileStream
+		getAuthorization func(*mock.Mock) mock.Call
+		apiUpload        func(*mock.Mock) mock.Call
+		checker          func(*common.IdResponse, error)
+	}{
+		{
+			name: "when file stream is correct then upload file",
+			file: common.FileStream{
+				Reader:   strings.NewReader("%PDF-1.4"),
+				Filename: "evidence.pdf",
+				Purpose:  common.DisputesEvidence,
+			},
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, "/files", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(4).(*common.IdResponse)
+						*respMapping = response
+					})
+			},
+			checker: func(response *common.IdResponse, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, response)
+				assert.Equal(t, "file_123", response.Id)
+			},
+		},
+		{
+			name: "when filename is missing then return error",
+			file: common.FileStream{
+				Reader:  strings.NewReader("%PDF-1.4"),
+				Purpose: common.DisputesEvidence,
+			},
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(response *common.IdResponse, err error) {
+				assert.Nil(t, response)
+				assert.NotNil(t, err)
+				assert.Equal(t, "Invalid file name", err.Error())
+			},
+		},
+		{
+			name: "when credentials invalid then return error",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(nil, errors.CheckoutAuthorizationError("Invalid authorization type"))
+			},
+			apiUpload: func(m *mock.Mock) mock.Call {
+				return *m.On("UploadWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(response *common.IdResponse, err error) {
+				assert.Nil(t, response)
+				assert.NotNil(t, err)
+				chkErr := err.(errors.CheckoutAuthorizationError)
+				assert.Equal(t, "Invalid authorization type", chkErr.Error())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiUpload(&apiClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient)
+
+			tc.checker(client.UploadFileStream(tc.file))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+//go:build go1.23
+
+package disputes
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+// DisputesPager pages through the disputes matching the filter, starting at queryFilter.Skip.
+func (c *Client) DisputesPager(queryFilter QueryFilter, opts ...client.RequestOption) *common.Pager[DisputeSummary] {
+	return common.NewOffsetPager(queryFilter.Skip, int(queryFilter.Limit), func(ctx context.Context, skip, limit int) ([]DisputeSummary, int, error) {
+		pageFilter := queryFilter
+		pageFilter.Skip, pageFilter.Limit = skip, uint8(limit)
+		response, err := c.QueryWithContext(ctx, pageFilter, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Data, response.TotalCount, nil
+	})
+}
+
+func (c *Client) AllDisputes(ctx context.Context, queryFilter QueryFilter, opts ...client.RequestOption) iter.Seq2[DisputeSummary, error] {
+	return c.DisputesPager(queryFilter, opts...).All(ctx)
+}
@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package disputes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/mocks"
+)
+
+func TestAllDisputes(t *testing.T) {
+	pages := map[string]QueryResponse{
+		"/disputes?limit=2&statuses=evidence_required":        {TotalCount: 3, Data: []DisputeSummary{{Id: "dsp_1"}, {Id: "dsp_2"}}},
+		"/disputes?limit=2&skip=2&statuses=evidence_required": {TotalCount: 3, Data: []DisputeSummary{{Id: "dsp_3"}}},
+	}
+
+	apiClient := new(mocks.ApiClientMock)
+	credentials := new(mocks.CredentialsMock)
+	credentials.On("GetAuthorization", mock.Anything).Return(&configuration.SdkAuthorization{}, nil)
+	apiClient.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			*args.Get(3).(*QueryResponse) = pages[args.String(1)]
+		})
+
+	enableTelemetry := true
+	config := configuration.NewConfiguration(credentials, &enableTelemetry, new(mocks.EnvironmentMock), &http.Client{}, nil)
+	client := NewClient(config, apiClient)
+
+	var ids []string
+	for dispute, err := range client.AllDisputes(context.Background(), QueryFilter{Limit: 2, Statuses: "evidence_required"}) {
+		assert.Nil(t, err)
+		ids = append(ids, dispute.Id)
+	}
+
+	assert.Equal(t, []string{"dsp_1", "dsp_2", "dsp_3"}, ids)
+	apiClient.AssertNumberOfCalls(t, "GetWithContext", 2)
+}
@@ -0,0 +1,104 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorKind is the type of the sentinel errors matched by CheckoutAPIError through errors.Is.
+type ErrorKind string
+
+const (
+	ErrNotFound     ErrorKind = "not found"
+	ErrConflict     ErrorKind = "conflict"
+	ErrRateLimited  ErrorKind = "rate limited"
+	ErrValidation   ErrorKind = "validation failed"
+	ErrUnauthorized ErrorKind = "unauthorized"
+	ErrRetryable    ErrorKind = "retryable"
+)
+
+const requestInvalid = "request_invalid"
+
+func (k ErrorKind) Error() string { return string(k) }
+
+func (e CheckoutAPIError) Error() string {
+	var b strings.Builder
+	if e.Operation != "" {
+		b.WriteString(e.Operation)
+		b.WriteString(": ")
+	}
+
+	if e.Status != "" {
+		b.WriteString(e.Status)
+	} else {
+		fmt.Fprintf(&b, "%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	if e.Data != nil && e.Data.ErrorType != "" {
+		b.WriteString(": ")
+		b.WriteString(e.Data.ErrorType)
+	}
+	if e.Data != nil && len(e.Data.ErrorCodes) > 0 {
+		fmt.Fprintf(&b, " [%s]", strings.Join(e.Data.ErrorCodes, ", "))
+	}
+	if e.RequestId != "" {
+		fmt.Fprintf(&b, " (request id %s)", e.RequestId)
+	}
+
+	return b.String()
+}
+
+// Is matches the sentinel errors, so that errors.Is(err, errors.ErrNotFound) holds for a 404 response.
+func (e CheckoutAPIError) Is(target error) bool {
+	kind, ok := target.(ErrorKind)
+	if !ok {
+		return false
+	}
+
+	switch kind {
+	case ErrNotFound:
+		return e.IsNotFound()
+	case ErrConflict:
+		return e.IsConflict()
+	case ErrRateLimited:
+		return e.IsRateLimited()
+	case ErrValidation:
+		return e.IsValidation()
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrRetryable:
+		return e.IsRetryable()
+	default:
+		return false
+	}
+}
+
+func (e CheckoutAPIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+func (e CheckoutAPIError) IsConflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+func (e CheckoutAPIError) IsRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsValidation reports whether the request was rejected because of invalid fields, listed in Data.ErrorCodes.
+func (e CheckoutAPIError) IsValidation() bool {
+	return e.StatusCode == http.StatusUnprocessableEntity || (e.Data != nil && e.Data.ErrorType == requestInvalid)
+}
+
+// IsRetryable reports whether sending the same request again may succeed: the API was rate limited, or a gateway
+// failed before the request was processed.
+func (e CheckoutAPIError) IsRetryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,71 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckoutAPIError_Error(t *testing.T) {
+	err := CheckoutAPIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Status:     "422 Unprocessable Entity",
+		Data:       &ErrorDetails{ErrorType: "request_invalid", ErrorCodes: []string{"amount_required", "currency_required"}},
+		RequestId:  "req_123",
+		Operation:  "payments.RequestPayment",
+	}
+
+	assert.Equal(t,
+		"payments.RequestPayment: 422 Unprocessable Entity: request_invalid [amount_required, currency_required] (request id req_123)",
+		err.Error())
+	assert.Equal(t, "503 Service Unavailable", CheckoutAPIError{StatusCode: http.StatusServiceUnavailable}.Error())
+}
+
+func TestCheckoutAPIError_Helpers(t *testing.T) {
+	cases := []struct {
+		statusCode int
+		errorType  string
+		expected   []ErrorKind
+	}{
+		{statusCode: http.StatusBadRequest},
+		{statusCode: http.StatusBadRequest, errorType: "request_invalid", expected: []ErrorKind{ErrValidation}},
+		{statusCode: http.StatusUnauthorized, expected: []ErrorKind{ErrUnauthorized}},
+		{statusCode: http.StatusNotFound, expected: []ErrorKind{ErrNotFound}},
+		{statusCode: http.StatusConflict, expected: []ErrorKind{ErrConflict}},
+		{statusCode: http.StatusUnprocessableEntity, expected: []ErrorKind{ErrValidation}},
+		{statusCode: http.StatusTooManyRequests, expected: []ErrorKind{ErrRateLimited, ErrRetryable}},
+		{statusCode: http.StatusInternalServerError},
+		{statusCode: http.StatusBadGateway, expected: []ErrorKind{ErrRetryable}},
+		{statusCode: http.StatusServiceUnavailable, expected: []ErrorKind{ErrRetryable}},
+	}
+
+	kinds := []ErrorKind{ErrNotFound, ErrConflict, ErrRateLimited, ErrValidation, ErrUnauthorized, ErrRetryable}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%d %s", tc.statusCode, tc.errorType), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", CheckoutAPIError{StatusCode: tc.statusCode, Data: &ErrorDetails{ErrorType: tc.errorType}})
+			for _, kind := range kinds {
+				assert.Equal(t, contains(tc.expected, kind), errors.Is(err, kind), kind)
+			}
+
+			var apiErr CheckoutAPIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, contains(tc.expected, ErrNotFound), apiErr.IsNotFound())
+			assert.Equal(t, contains(tc.expected, ErrConflict), apiErr.IsConflict())
+			assert.Equal(t, contains(tc.expected, ErrRateLimited), apiErr.IsRateLimited())
+			assert.Equal(t, contains(tc.expected, ErrValidation), apiErr.IsValidation())
+			assert.Equal(t, contains(tc.expected, ErrRetryable), apiErr.IsRetryable())
+		})
+	}
+}
+
+func contains(kinds []ErrorKind, kind ErrorKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
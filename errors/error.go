@@ -1,6 +1,10 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 type ErrorDetails struct {
 	RequestID  string                 `json:"request_id,omitempty"`
@@ -18,17 +22,67 @@ type (
 		StatusCode int
 		Status     string
 		Data       *ErrorDetails
+		// RawBody is the body of the response as received, even when it could not be parsed
+		RawBody   []byte
+		RequestId string
+		// Operation names the client method that failed, for example "payments.RequestPayment"
+		Operation string
 	}
 
+	// CheckoutOAuthError is returned when the authorization server rejects a token request
 	CheckoutOAuthError struct {
 		Description string `json:"error"`
+		// ErrorDescription is the optional explanation of Description given by the authorization server
+		ErrorDescription string `json:"error_description,omitempty"`
+		StatusCode       int    `json:"-"`
+		Status           string `json:"-"`
+		// RawBody is the body of the response of the token endpoint, even when it could not be parsed
+		RawBody []byte `json:"-"`
+	}
+
+	// CheckoutScopeError is returned without calling the API when an operation requires OAuth scopes that were not
+	// granted to the client
+	CheckoutScopeError struct {
+		Operation string
+		Scopes    []string
+	}
+
+	// CircuitOpenError is returned without calling the API while the circuit breaker of a host is open
+	CircuitOpenError struct {
+		Host      string
+		OpenUntil time.Time
 	}
 )
 
 func (e CheckoutArgumentError) Error() string      { return string(e) }
 func (e CheckoutAuthorizationError) Error() string { return string(e) }
-func (e CheckoutAPIError) Error() string           { return e.Status }
-func (e CheckoutOAuthError) Error() string         { return e.Description }
+func (e CheckoutOAuthError) Error() string {
+	if e.Description == "" {
+		return e.Status
+	}
+	return e.Description
+}
+
+// AuthorizationError returns the CheckoutAuthorizationError the SDK returned for a rejected token request before
+// CheckoutOAuthError held the response.
+func (e CheckoutOAuthError) AuthorizationError() CheckoutAuthorizationError {
+	return CheckoutAuthorizationError(e.Error())
+}
+
+// As lets errors.As match a CheckoutOAuthError as the CheckoutAuthorizationError returned by previous versions.
+func (e CheckoutOAuthError) As(target interface{}) bool {
+	if authorizationError, ok := target.(*CheckoutAuthorizationError); ok {
+		*authorizationError = e.AuthorizationError()
+		return true
+	}
+	return false
+}
+func (e CheckoutScopeError) Error() string {
+	return fmt.Sprintf("%s requires the OAuth scopes %s, which are not granted to the client", e.Operation, strings.Join(e.Scopes, ", "))
+}
+func (e CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %s until %s", e.Host, e.OpenUntil.Format(time.RFC3339))
+}
 
 type (
 	UnsupportedTypeError string
@@ -0,0 +1,75 @@
+package errors
+
+//go:generate go run gen_error_codes.go
+
+// ErrorCode is one of the values listed in ErrorDetails.ErrorCodes, for example "card_number_invalid".
+type ErrorCode string
+
+type ErrorCategory string
+
+const (
+	// CategoryValidation codes reject a request field that is missing or malformed
+	CategoryValidation ErrorCategory = "validation"
+	// CategoryAuthentication codes reject the credentials or the permissions of the caller
+	CategoryAuthentication ErrorCategory = "authentication"
+	// CategoryBusinessRule codes reject a well formed request that cannot be processed in the current state
+	CategoryBusinessRule ErrorCategory = "business_rule"
+	// CategoryUnknown is used for the codes missing from the catalogue
+	CategoryUnknown ErrorCategory = "unknown"
+)
+
+// ErrorCodeInfo describes an error code of the catalogue.
+type ErrorCodeInfo struct {
+	Code ErrorCode
+	// Field is the path of the request field the code refers to, for example "source.number". It is empty when
+	// the code does not refer to a single field
+	Field    string
+	Message  string
+	Category ErrorCategory
+}
+
+var errorCodeIndex = indexErrorCodes(errorCodeCatalogue)
+
+func indexErrorCodes(catalogue []ErrorCodeInfo) map[ErrorCode]ErrorCodeInfo {
+	index := make(map[ErrorCode]ErrorCodeInfo, len(catalogue))
+	for _, info := range catalogue {
+		index[info.Code] = info
+	}
+	return index
+}
+
+// LookupErrorCode returns the catalogue entry of an error code.
+func LookupErrorCode(code string) (ErrorCodeInfo, bool) {
+	info, ok := errorCodeIndex[ErrorCode(code)]
+	return info, ok
+}
+
+// ErrorCodes returns the catalogue entries of the error codes of the response, in the order they were received.
+// Codes missing from the catalogue are kept with the CategoryUnknown category and no field.
+func (e CheckoutAPIError) ErrorCodes() []ErrorCodeInfo {
+	if e.Data == nil || len(e.Data.ErrorCodes) == 0 {
+		return nil
+	}
+
+	infos := make([]ErrorCodeInfo, 0, len(e.Data.ErrorCodes))
+	for _, code := range e.Data.ErrorCodes {
+		info, ok := LookupErrorCode(code)
+		if !ok {
+			info = ErrorCodeInfo{Code: ErrorCode(code), Message: code, Category: CategoryUnknown}
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// FieldErrors groups the error codes of the response by the request field they refer to, so that a rejection can
+// be reported next to the form inputs it concerns. Codes that do not refer to a field are left out.
+func (e CheckoutAPIError) FieldErrors() map[string][]ErrorCodeInfo {
+	fields := make(map[string][]ErrorCodeInfo)
+	for _, info := range e.ErrorCodes() {
+		if info.Field != "" {
+			fields[info.Field] = append(fields[info.Field], info)
+		}
+	}
+	return fields
+}
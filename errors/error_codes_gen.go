@@ -0,0 +1,181 @@
+// Code generated by gen_error_codes.go from error_codes.csv; DO NOT EDIT.
+
+package errors
+
+const (
+	ThreeDsNotConfigured                      ErrorCode = "3ds_not_configured"
+	ThreeDsNotEnabledForCard                  ErrorCode = "3ds_not_enabled_for_card"
+	ThreeDsNotSupported                       ErrorCode = "3ds_not_supported"
+	ThreeDsPaymentRequired                    ErrorCode = "3ds_payment_required"
+	ThreeDsVersionInvalid                     ErrorCode = "3ds_version_invalid"
+	ActionIdInvalid                           ErrorCode = "action_id_invalid"
+	AmountExceedsBalance                      ErrorCode = "amount_exceeds_balance"
+	AmountInvalid                             ErrorCode = "amount_invalid"
+	AmountRequired                            ErrorCode = "amount_required"
+	ApiCallsQuotaExceeded                     ErrorCode = "api_calls_quota_exceeded"
+	BillingAddressAddressLine1Invalid         ErrorCode = "billing_address_address_line1_invalid"
+	BillingAddressAddressLine2Invalid         ErrorCode = "billing_address_address_line2_invalid"
+	BillingAddressCityInvalid                 ErrorCode = "billing_address_city_invalid"
+	BillingAddressCountryInvalid              ErrorCode = "billing_address_country_invalid"
+	BillingAddressInvalid                     ErrorCode = "billing_address_invalid"
+	BillingAddressStateInvalid                ErrorCode = "billing_address_state_invalid"
+	BillingAddressZipInvalid                  ErrorCode = "billing_address_zip_invalid"
+	BillingDescriptorCityInvalid              ErrorCode = "billing_descriptor_city_invalid"
+	BillingDescriptorNameInvalid              ErrorCode = "billing_descriptor_name_invalid"
+	BusinessInvalid                           ErrorCode = "business_invalid"
+	CaptureValueGreaterThanAuthorized         ErrorCode = "capture_value_greater_than_authorized"
+	CardDisabled                              ErrorCode = "card_disabled"
+	CardExpired                               ErrorCode = "card_expired"
+	CardExpiryMonthInvalid                    ErrorCode = "card_expiry_month_invalid"
+	CardExpiryMonthRequired                   ErrorCode = "card_expiry_month_required"
+	CardExpiryYearInvalid                     ErrorCode = "card_expiry_year_invalid"
+	CardExpiryYearRequired                    ErrorCode = "card_expiry_year_required"
+	CardHolderInvalid                         ErrorCode = "card_holder_invalid"
+	CardNotFound                              ErrorCode = "card_not_found"
+	CardNumberInvalid                         ErrorCode = "card_number_invalid"
+	CardNumberRequired                        ErrorCode = "card_number_required"
+	ChannelDetailsInvalid                     ErrorCode = "channel_details_invalid"
+	CountryInvalid                            ErrorCode = "country_invalid"
+	CountryPhoneCodeInvalid                   ErrorCode = "country_phone_code_invalid"
+	CurrencyInvalid                           ErrorCode = "currency_invalid"
+	CurrencyRequired                          ErrorCode = "currency_required"
+	CustomerAlreadyExists                     ErrorCode = "customer_already_exists"
+	CustomerEmailInvalid                      ErrorCode = "customer_email_invalid"
+	CustomerIdInvalid                         ErrorCode = "customer_id_invalid"
+	CustomerNameInvalid                       ErrorCode = "customer_name_invalid"
+	CustomerNotFound                          ErrorCode = "customer_not_found"
+	CustomerPhoneNumberInvalid                ErrorCode = "customer_phone_number_invalid"
+	CvvInvalid                                ErrorCode = "cvv_invalid"
+	DescriptionInvalid                        ErrorCode = "description_invalid"
+	EciInvalid                                ErrorCode = "eci_invalid"
+	EmailInvalid                              ErrorCode = "email_invalid"
+	EmailRequired                             ErrorCode = "email_required"
+	FailureUrlInvalid                         ErrorCode = "failure_url_invalid"
+	FieldsInvalid                             ErrorCode = "fields_invalid"
+	InstrumentIdInvalid                       ErrorCode = "instrument_id_invalid"
+	IpAddressInvalid                          ErrorCode = "ip_address_invalid"
+	MerchantDataDelegatedAuthenticationFailed ErrorCode = "merchant_data_delegated_authentication_failed"
+	MetadataKeyInvalid                        ErrorCode = "metadata_key_invalid"
+	NoAuthorizationEnabledProcessorsAvailable ErrorCode = "no_authorization_enabled_processors_available"
+	ParameterInvalid                          ErrorCode = "parameter_invalid"
+	PaymentExpired                            ErrorCode = "payment_expired"
+	PaymentIdInvalid                          ErrorCode = "payment_id_invalid"
+	PaymentInvalid                            ErrorCode = "payment_invalid"
+	PaymentMethodNotSupported                 ErrorCode = "payment_method_not_supported"
+	PaymentSourceRequired                     ErrorCode = "payment_source_required"
+	PaymentTypeInvalid                        ErrorCode = "payment_type_invalid"
+	PermissionDenied                          ErrorCode = "permission_denied"
+	PhoneNumberInvalid                        ErrorCode = "phone_number_invalid"
+	PreviousPaymentIdInvalid                  ErrorCode = "previous_payment_id_invalid"
+	ProcessingChannelIdInvalid                ErrorCode = "processing_channel_id_invalid"
+	ProcessingChannelIdRequired               ErrorCode = "processing_channel_id_required"
+	ProcessingKeyRequired                     ErrorCode = "processing_key_required"
+	ReferenceInvalid                          ErrorCode = "reference_invalid"
+	RefundAmountExceedsBalance                ErrorCode = "refund_amount_exceeds_balance"
+	RefundAuthorizationDeclined               ErrorCode = "refund_authorization_declined"
+	ShippingAddressInvalid                    ErrorCode = "shipping_address_invalid"
+	SourceEmailInvalid                        ErrorCode = "source_email_invalid"
+	SourceIdInvalid                           ErrorCode = "source_id_invalid"
+	SourceIdOrEmailRequired                   ErrorCode = "source_id_or_email_required"
+	SourceIdRequired                          ErrorCode = "source_id_required"
+	SourceInvalid                             ErrorCode = "source_invalid"
+	SourceTokenInvalid                        ErrorCode = "source_token_invalid"
+	SourceTypeRequired                        ErrorCode = "source_type_required"
+	SuccessUrlInvalid                         ErrorCode = "success_url_invalid"
+	TokenExpired                              ErrorCode = "token_expired"
+	TokenInUse                                ErrorCode = "token_in_use"
+	TokenInvalid                              ErrorCode = "token_invalid"
+	TokenRequired                             ErrorCode = "token_required"
+	TokenTypeRequired                         ErrorCode = "token_type_required"
+	TokenUsed                                 ErrorCode = "token_used"
+	VoidAmountInvalid                         ErrorCode = "void_amount_invalid"
+)
+
+var errorCodeCatalogue = []ErrorCodeInfo{
+	{Code: ThreeDsNotConfigured, Field: "3ds", Message: "3DS is not configured for this account", Category: CategoryBusinessRule},
+	{Code: ThreeDsNotEnabledForCard, Field: "3ds", Message: "3DS is not enabled for the card", Category: CategoryBusinessRule},
+	{Code: ThreeDsNotSupported, Field: "3ds", Message: "3DS is not supported for this payment", Category: CategoryBusinessRule},
+	{Code: ThreeDsPaymentRequired, Field: "3ds", Message: "The payment requires 3DS authentication", Category: CategoryBusinessRule},
+	{Code: ThreeDsVersionInvalid, Field: "3ds.version", Message: "The 3DS version is invalid", Category: CategoryValidation},
+	{Code: ActionIdInvalid, Field: "action_id", Message: "The action id is invalid", Category: CategoryValidation},
+	{Code: AmountExceedsBalance, Field: "amount", Message: "The amount exceeds the balance available for this action", Category: CategoryBusinessRule},
+	{Code: AmountInvalid, Field: "amount", Message: "The amount is invalid", Category: CategoryValidation},
+	{Code: AmountRequired, Field: "amount", Message: "The amount is required", Category: CategoryValidation},
+	{Code: ApiCallsQuotaExceeded, Field: "", Message: "The quota of API calls has been exceeded", Category: CategoryBusinessRule},
+	{Code: BillingAddressAddressLine1Invalid, Field: "billing_address.address_line1", Message: "The first line of the billing address is invalid", Category: CategoryValidation},
+	{Code: BillingAddressAddressLine2Invalid, Field: "billing_address.address_line2", Message: "The second line of the billing address is invalid", Category: CategoryValidation},
+	{Code: BillingAddressCityInvalid, Field: "billing_address.city", Message: "The billing address city is invalid", Category: CategoryValidation},
+	{Code: BillingAddressCountryInvalid, Field: "billing_address.country", Message: "The billing address country is invalid", Category: CategoryValidation},
+	{Code: BillingAddressInvalid, Field: "billing_address", Message: "The billing address is invalid", Category: CategoryValidation},
+	{Code: BillingAddressStateInvalid, Field: "billing_address.state", Message: "The billing address state is invalid", Category: CategoryValidation},
+	{Code: BillingAddressZipInvalid, Field: "billing_address.zip", Message: "The billing address postal code is invalid", Category: CategoryValidation},
+	{Code: BillingDescriptorCityInvalid, Field: "billing_descriptor.city", Message: "The billing descriptor city is invalid", Category: CategoryValidation},
+	{Code: BillingDescriptorNameInvalid, Field: "billing_descriptor.name", Message: "The billing descriptor name is invalid", Category: CategoryValidation},
+	{Code: BusinessInvalid, Field: "", Message: "The business associated with the credentials is invalid", Category: CategoryAuthentication},
+	{Code: CaptureValueGreaterThanAuthorized, Field: "amount", Message: "The capture amount is greater than the authorized amount", Category: CategoryBusinessRule},
+	{Code: CardDisabled, Field: "source", Message: "The card is disabled", Category: CategoryBusinessRule},
+	{Code: CardExpired, Field: "source.expiry_year", Message: "The card has expired", Category: CategoryBusinessRule},
+	{Code: CardExpiryMonthInvalid, Field: "source.expiry_month", Message: "The card expiry month is invalid", Category: CategoryValidation},
+	{Code: CardExpiryMonthRequired, Field: "source.expiry_month", Message: "The card expiry month is required", Category: CategoryValidation},
+	{Code: CardExpiryYearInvalid, Field: "source.expiry_year", Message: "The card expiry year is invalid", Category: CategoryValidation},
+	{Code: CardExpiryYearRequired, Field: "source.expiry_year", Message: "The card expiry year is required", Category: CategoryValidation},
+	{Code: CardHolderInvalid, Field: "source.name", Message: "The cardholder name is invalid", Category: CategoryValidation},
+	{Code: CardNotFound, Field: "source.id", Message: "The card could not be found", Category: CategoryBusinessRule},
+	{Code: CardNumberInvalid, Field: "source.number", Message: "The card number is invalid", Category: CategoryValidation},
+	{Code: CardNumberRequired, Field: "source.number", Message: "The card number is required", Category: CategoryValidation},
+	{Code: ChannelDetailsInvalid, Field: "", Message: "The channel details are invalid", Category: CategoryValidation},
+	{Code: CountryInvalid, Field: "country", Message: "The country is invalid", Category: CategoryValidation},
+	{Code: CountryPhoneCodeInvalid, Field: "phone.country_code", Message: "The phone country code is invalid", Category: CategoryValidation},
+	{Code: CurrencyInvalid, Field: "currency", Message: "The currency is invalid", Category: CategoryValidation},
+	{Code: CurrencyRequired, Field: "currency", Message: "The currency is required", Category: CategoryValidation},
+	{Code: CustomerAlreadyExists, Field: "customer.email", Message: "A customer with this email already exists", Category: CategoryBusinessRule},
+	{Code: CustomerEmailInvalid, Field: "customer.email", Message: "The customer email is invalid", Category: CategoryValidation},
+	{Code: CustomerIdInvalid, Field: "customer.id", Message: "The customer id is invalid", Category: CategoryValidation},
+	{Code: CustomerNameInvalid, Field: "customer.name", Message: "The customer name is invalid", Category: CategoryValidation},
+	{Code: CustomerNotFound, Field: "customer.id", Message: "The customer could not be found", Category: CategoryBusinessRule},
+	{Code: CustomerPhoneNumberInvalid, Field: "customer.phone.number", Message: "The customer phone number is invalid", Category: CategoryValidation},
+	{Code: CvvInvalid, Field: "source.cvv", Message: "The card verification value is invalid", Category: CategoryValidation},
+	{Code: DescriptionInvalid, Field: "description", Message: "The description is invalid", Category: CategoryValidation},
+	{Code: EciInvalid, Field: "3ds.eci", Message: "The 3DS ECI indicator is invalid", Category: CategoryValidation},
+	{Code: EmailInvalid, Field: "email", Message: "The email is invalid", Category: CategoryValidation},
+	{Code: EmailRequired, Field: "email", Message: "The email is required", Category: CategoryValidation},
+	{Code: FailureUrlInvalid, Field: "failure_url", Message: "The failure url is invalid", Category: CategoryValidation},
+	{Code: FieldsInvalid, Field: "", Message: "One or more fields are invalid", Category: CategoryValidation},
+	{Code: InstrumentIdInvalid, Field: "source.id", Message: "The instrument id is invalid", Category: CategoryValidation},
+	{Code: IpAddressInvalid, Field: "payment_ip", Message: "The IP address is invalid", Category: CategoryValidation},
+	{Code: MerchantDataDelegatedAuthenticationFailed, Field: "", Message: "The delegated authentication of the merchant data failed", Category: CategoryAuthentication},
+	{Code: MetadataKeyInvalid, Field: "metadata", Message: "A metadata key is invalid", Category: CategoryValidation},
+	{Code: NoAuthorizationEnabledProcessorsAvailable, Field: "", Message: "No processor enabled for authorizations is available", Category: CategoryBusinessRule},
+	{Code: ParameterInvalid, Field: "", Message: "A parameter is invalid", Category: CategoryValidation},
+	{Code: PaymentExpired, Field: "", Message: "The payment has expired", Category: CategoryBusinessRule},
+	{Code: PaymentIdInvalid, Field: "payment_id", Message: "The payment id is invalid", Category: CategoryValidation},
+	{Code: PaymentInvalid, Field: "", Message: "The payment cannot be used for this action", Category: CategoryBusinessRule},
+	{Code: PaymentMethodNotSupported, Field: "source.type", Message: "The payment method is not supported", Category: CategoryBusinessRule},
+	{Code: PaymentSourceRequired, Field: "source", Message: "The payment source is required", Category: CategoryValidation},
+	{Code: PaymentTypeInvalid, Field: "payment_type", Message: "The payment type is invalid", Category: CategoryValidation},
+	{Code: PermissionDenied, Field: "", Message: "The credentials do not grant access to this resource", Category: CategoryAuthentication},
+	{Code: PhoneNumberInvalid, Field: "phone.number", Message: "The phone number is invalid", Category: CategoryValidation},
+	{Code: PreviousPaymentIdInvalid, Field: "previous_payment_id", Message: "The previous payment id is invalid", Category: CategoryValidation},
+	{Code: ProcessingChannelIdInvalid, Field: "processing_channel_id", Message: "The processing channel id is invalid", Category: CategoryValidation},
+	{Code: ProcessingChannelIdRequired, Field: "processing_channel_id", Message: "The processing channel id is required", Category: CategoryValidation},
+	{Code: ProcessingKeyRequired, Field: "", Message: "The credentials are not configured for processing", Category: CategoryAuthentication},
+	{Code: ReferenceInvalid, Field: "reference", Message: "The reference is invalid", Category: CategoryValidation},
+	{Code: RefundAmountExceedsBalance, Field: "amount", Message: "The refund amount exceeds the captured amount", Category: CategoryBusinessRule},
+	{Code: RefundAuthorizationDeclined, Field: "", Message: "The refund was declined", Category: CategoryBusinessRule},
+	{Code: ShippingAddressInvalid, Field: "shipping.address", Message: "The shipping address is invalid", Category: CategoryValidation},
+	{Code: SourceEmailInvalid, Field: "source.email", Message: "The source email is invalid", Category: CategoryValidation},
+	{Code: SourceIdInvalid, Field: "source.id", Message: "The source id is invalid", Category: CategoryValidation},
+	{Code: SourceIdOrEmailRequired, Field: "source", Message: "A source id or email is required", Category: CategoryValidation},
+	{Code: SourceIdRequired, Field: "source.id", Message: "The source id is required", Category: CategoryValidation},
+	{Code: SourceInvalid, Field: "source", Message: "The source is invalid", Category: CategoryValidation},
+	{Code: SourceTokenInvalid, Field: "source.token", Message: "The source token is invalid", Category: CategoryValidation},
+	{Code: SourceTypeRequired, Field: "source.type", Message: "The source type is required", Category: CategoryValidation},
+	{Code: SuccessUrlInvalid, Field: "success_url", Message: "The success url is invalid", Category: CategoryValidation},
+	{Code: TokenExpired, Field: "source.token", Message: "The token has expired", Category: CategoryBusinessRule},
+	{Code: TokenInUse, Field: "source.token", Message: "The token is already being used", Category: CategoryBusinessRule},
+	{Code: TokenInvalid, Field: "source.token", Message: "The token is invalid", Category: CategoryValidation},
+	{Code: TokenRequired, Field: "source.token", Message: "The token is required", Category: CategoryValidation},
+	{Code: TokenTypeRequired, Field: "type", Message: "The token type is required", Category: CategoryValidation},
+	{Code: TokenUsed, Field: "source.token", Message: "The token has already been used", Category: CategoryBusinessRule},
+	{Code: VoidAmountInvalid, Field: "amount", Message: "The void amount is invalid", Category: CategoryValidation},
+}
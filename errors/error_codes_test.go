@@ -0,0 +1,57 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupErrorCode(t *testing.T) {
+	info, ok := LookupErrorCode("card_number_invalid")
+	assert.True(t, ok)
+	assert.Equal(t, ErrorCodeInfo{
+		Code:     CardNumberInvalid,
+		Field:    "source.number",
+		Message:  "The card number is invalid",
+		Category: CategoryValidation,
+	}, info)
+
+	info, ok = LookupErrorCode(string(ThreeDsNotSupported))
+	assert.True(t, ok)
+	assert.Equal(t, CategoryBusinessRule, info.Category)
+
+	_, ok = LookupErrorCode("not_a_code")
+	assert.False(t, ok)
+}
+
+func TestErrorCodeCatalogue(t *testing.T) {
+	assert.Len(t, errorCodeIndex, len(errorCodeCatalogue), "duplicate code in the catalogue")
+	for _, info := range errorCodeCatalogue {
+		assert.NotEmpty(t, info.Message, info.Code)
+		assert.Contains(t, []ErrorCategory{CategoryValidation, CategoryAuthentication, CategoryBusinessRule}, info.Category, info.Code)
+	}
+}
+
+func TestCheckoutAPIError_FieldErrors(t *testing.T) {
+	err := CheckoutAPIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Data: &ErrorDetails{
+			ErrorType:  "request_invalid",
+			ErrorCodes: []string{"card_number_invalid", "cvv_invalid", "card_expiry_month_required", "payment_expired", "brand_new_code"},
+		},
+	}
+
+	codes := err.ErrorCodes()
+	assert.Len(t, codes, 5)
+	assert.Equal(t, CardNumberInvalid, codes[0].Code)
+	assert.Equal(t, ErrorCodeInfo{Code: "brand_new_code", Message: "brand_new_code", Category: CategoryUnknown}, codes[4])
+
+	fields := err.FieldErrors()
+	assert.Len(t, fields, 3)
+	assert.Equal(t, CvvInvalid, fields["source.cvv"][0].Code)
+	assert.Equal(t, CardExpiryMonthRequired, fields["source.expiry_month"][0].Code)
+
+	assert.Nil(t, CheckoutAPIError{StatusCode: http.StatusNotFound}.ErrorCodes())
+	assert.Empty(t, CheckoutAPIError{StatusCode: http.StatusNotFound}.FieldErrors())
+}
@@ -1,25 +1,14 @@
 package errors
 
-import (
-	"encoding/json"
-	"net/http"
-)
+import "encoding/json"
 
+// HandleError builds the error returned for a response with a 4xx or 5xx status. The status code, the raw body and
+// the request id are always kept, and Data holds the error details when the body could be parsed.
 func HandleError(statusCode int, status string, requestId string, body []byte) CheckoutAPIError {
-	if statusCode >= http.StatusInternalServerError {
-		return CheckoutAPIError{
-			StatusCode: statusCode,
-			Status:     string(body),
-		}
-	}
-
 	var details ErrorDetails
 	if len(body) != 0 {
 		if err := json.Unmarshal(body, &details); err != nil {
-			return CheckoutAPIError{
-				StatusCode: http.StatusBadRequest,
-				Status:     "Unparsable error",
-			}
+			details = ErrorDetails{}
 		}
 	}
 
@@ -29,5 +18,7 @@ func HandleError(statusCode int, status string, requestId string, body []byte) C
 		StatusCode: statusCode,
 		Status:     status,
 		Data:       &details,
+		RawBody:    body,
+		RequestId:  requestId,
 	}
 }
@@ -30,6 +30,7 @@ func TestHandleError(t *testing.T) {
 					ErrorType:  "",
 					ErrorCodes: nil,
 				},
+				RequestId: "12345",
 			},
 		},
 		{
@@ -46,17 +47,36 @@ func TestHandleError(t *testing.T) {
 					ErrorType:  "request_invalid",
 					ErrorCodes: []string{"invalid"},
 				},
+				RawBody:   getErrorBody(),
+				RequestId: "12345",
 			},
 		},
 		{
-			name:            "when error body is invalid then return unparsable error",
+			name:            "when error body is invalid then keep status and raw body",
 			inputStatusCode: http.StatusNotFound,
 			inputStatus:     "404 Not Found",
 			inputRequestId:  "12345",
 			inputBody:       []byte("unparsable_body"),
 			expectedError: CheckoutAPIError{
-				StatusCode: http.StatusBadRequest,
-				Status:     "Unparsable error",
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Data:       &ErrorDetails{RequestID: "12345"},
+				RawBody:    []byte("unparsable_body"),
+				RequestId:  "12345",
+			},
+		},
+		{
+			name:            "when server error then keep status and raw body",
+			inputStatusCode: http.StatusBadGateway,
+			inputStatus:     "502 Bad Gateway",
+			inputRequestId:  "12345",
+			inputBody:       []byte("<html>Bad Gateway</html>"),
+			expectedError: CheckoutAPIError{
+				StatusCode: http.StatusBadGateway,
+				Status:     "502 Bad Gateway",
+				Data:       &ErrorDetails{RequestID: "12345"},
+				RawBody:    []byte("<html>Bad Gateway</html>"),
+				RequestId:  "12345",
 			},
 		},
 	}
@@ -0,0 +1,77 @@
+//go:build ignore
+
+// gen_error_codes.go generates error_codes_gen.go from the error_codes.csv catalogue. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+var categories = map[string]string{
+	"validation":     "CategoryValidation",
+	"authentication": "CategoryAuthentication",
+	"business_rule":  "CategoryBusinessRule",
+}
+
+func main() {
+	file, err := os.Open("error_codes.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var constants, catalogue bytes.Buffer
+	for _, record := range records[1:] {
+		code, field, category, message := record[0], record[1], record[2], record[3]
+		categoryName, ok := categories[category]
+		if !ok {
+			log.Fatalf("unknown category %q for %s", category, code)
+		}
+
+		name := identifier(code)
+		fmt.Fprintf(&constants, "\t%s ErrorCode = %q\n", name, code)
+		fmt.Fprintf(&catalogue, "\t{Code: %s, Field: %q, Message: %q, Category: %s},\n", name, field, message, categoryName)
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by gen_error_codes.go from error_codes.csv; DO NOT EDIT.\n\n")
+	source.WriteString("package errors\n\n")
+	fmt.Fprintf(&source, "const (\n%s)\n\n", constants.String())
+	fmt.Fprintf(&source, "var errorCodeCatalogue = []ErrorCodeInfo{\n%s}\n", catalogue.String())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile("error_codes_gen.go", formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// identifier turns "card_number_invalid" into "CardNumberInvalid" and "3ds_not_supported" into "ThreeDsNotSupported".
+func identifier(code string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(code, "_") {
+		switch word {
+		case "":
+			continue
+		case "3ds":
+			b.WriteString("ThreeDs")
+		default:
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
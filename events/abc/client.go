@@ -23,8 +23,9 @@ func (c *Client) RetrieveAllEventTypes() (*EventTypesResponse, error) {
 	return c.RetrieveAllEventTypesWithContext(context.Background())
 }
 
-func (c *Client) RetrieveAllEventTypesWithContext(ctx context.Context) (*EventTypesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveAllEventTypesWithContext(ctx context.Context, opts ...client.RequestOption) (*EventTypesResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +48,10 @@ func (c *Client) RetrieveAllEventTypesQuery(
 func (c *Client) RetrieveAllEventTypesQueryWithContext(
 	ctx context.Context,
 	query QueryRetrieveAllEventType,
+	opts ...client.RequestOption,
 ) (*EventTypesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -72,8 +75,9 @@ func (c *Client) RetrieveEvents() (*EventsPageResponse, error) {
 	return c.RetrieveEventsWithContext(context.Background())
 }
 
-func (c *Client) RetrieveEventsWithContext(ctx context.Context) (*EventsPageResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveEventsWithContext(ctx context.Context, opts ...client.RequestOption) (*EventsPageResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -91,8 +95,9 @@ func (c *Client) RetrieveEventsQuery(query QueryRetrieveEvents) (*EventsPageResp
 	return c.RetrieveEventsQueryWithContext(context.Background(), query)
 }
 
-func (c *Client) RetrieveEventsQueryWithContext(ctx context.Context, query QueryRetrieveEvents) (*EventsPageResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveEventsQueryWithContext(ctx context.Context, query QueryRetrieveEvents, opts ...client.RequestOption) (*EventsPageResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +121,9 @@ func (c *Client) RetrieveEvent(eventId string) (*EventResponse, error) {
 	return c.RetrieveEventWithContext(context.Background(), eventId)
 }
 
-func (c *Client) RetrieveEventWithContext(ctx context.Context, eventId string) (*EventResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveEventWithContext(ctx context.Context, eventId string, opts ...client.RequestOption) (*EventResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -142,8 +148,10 @@ func (c *Client) RetrieveEventNotificationWithContext(
 	ctx context.Context,
 	eventId string,
 	notificationId string,
+	opts ...client.RequestOption,
 ) (*EventNotificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +169,9 @@ func (c *Client) RetryWebhook(eventId string, webhookId string) (*common.Metadat
 	return c.RetryWebhookWithContext(context.Background(), eventId, webhookId)
 }
 
-func (c *Client) RetryWebhookWithContext(ctx context.Context, eventId string, webhookId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetryWebhookWithContext(ctx context.Context, eventId string, webhookId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -187,8 +196,9 @@ func (c *Client) RetryAllWebhooks(eventId string) (*common.MetadataResponse, err
 	return c.RetryAllWebhooksWithContext(context.Background(), eventId)
 }
 
-func (c *Client) RetryAllWebhooksWithContext(ctx context.Context, eventId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetryAllWebhooksWithContext(ctx context.Context, eventId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,28 @@
+//go:build go1.23
+
+package abc
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+// EventsPager pages through the events matching the query, starting at query.Skip.
+func (c *Client) EventsPager(query QueryRetrieveEvents, opts ...client.RequestOption) *common.Pager[EventsSummaryResponse] {
+	return common.NewOffsetPager(query.Skip, query.Limit, func(ctx context.Context, skip, limit int) ([]EventsSummaryResponse, int, error) {
+		pageQuery := query
+		pageQuery.Skip, pageQuery.Limit = skip, limit
+		response, err := c.RetrieveEventsQueryWithContext(ctx, pageQuery, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Data, response.TotalCount, nil
+	})
+}
+
+func (c *Client) AllEvents(ctx context.Context, query QueryRetrieveEvents, opts ...client.RequestOption) iter.Seq2[EventsSummaryResponse, error] {
+	return c.EventsPager(query, opts...).All(ctx)
+}
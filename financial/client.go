@@ -24,8 +24,9 @@ func (c *Client) GetFinancialActions(query QueryFilter) (*QueryResponse, error)
 	return c.GetFinancialActionsWithContext(context.Background(), query)
 }
 
-func (c *Client) GetFinancialActionsWithContext(ctx context.Context, query QueryFilter) (*QueryResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetFinancialActionsWithContext(ctx context.Context, query QueryFilter, opts ...client.RequestOption) (*QueryResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
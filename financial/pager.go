@@ -0,0 +1,30 @@
+//go:build go1.23
+
+package financial
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+// FinancialActionsPager pages through the financial actions matching the query, starting at query.PaginationToken.
+func (c *Client) FinancialActionsPager(query QueryFilter, opts ...client.RequestOption) *common.Pager[FinancialAction] {
+	return common.NewPager(func(ctx context.Context, cursor string) (common.Page[FinancialAction], error) {
+		pageQuery := query
+		if cursor != "" {
+			pageQuery.PaginationToken = cursor
+		}
+		response, err := c.GetFinancialActionsWithContext(ctx, pageQuery, opts...)
+		if err != nil {
+			return common.Page[FinancialAction]{}, err
+		}
+		return common.Page[FinancialAction]{Items: response.Data, Next: common.NextPaginationToken(response.Links)}, nil
+	})
+}
+
+func (c *Client) AllFinancialActions(ctx context.Context, query QueryFilter, opts ...client.RequestOption) iter.Seq2[FinancialAction, error] {
+	return c.FinancialActionsPager(query, opts...).All(ctx)
+}
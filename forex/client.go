@@ -24,8 +24,9 @@ func (c *Client) RequestQuote(request QuoteRequest) (*QuoteResponse, error) {
 	return c.RequestQuoteWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestQuoteWithContext(ctx context.Context, request QuoteRequest) (*QuoteResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) RequestQuoteWithContext(ctx context.Context, request QuoteRequest, opts ...client.RequestOption) (*QuoteResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +51,9 @@ func (c *Client) GetRates(queryFilter RatesQuery) (*RatesResponse, error) {
 	return c.GetRatesWithContext(context.Background(), queryFilter)
 }
 
-func (c *Client) GetRatesWithContext(ctx context.Context, queryFilter RatesQuery) (*RatesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) GetRatesWithContext(ctx context.Context, queryFilter RatesQuery, opts ...client.RequestOption) (*RatesResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
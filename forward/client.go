@@ -24,8 +24,9 @@ func (c *Client) ForwardAnApiRequest(request ForwardRequest) (*ForwardAnApiRespo
 	return c.ForwardAnApiRequestWithContext(context.Background(), request)
 }
 
-func (c *Client) ForwardAnApiRequestWithContext(ctx context.Context, request ForwardRequest) (*ForwardAnApiResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) ForwardAnApiRequestWithContext(ctx context.Context, request ForwardRequest, opts ...client.RequestOption) (*ForwardAnApiResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) GetForwardRequest(forwardId string) (*GetForwardResponse, error
 	return c.GetForwardRequestWithContext(context.Background(), forwardId)
 }
 
-func (c *Client) GetForwardRequestWithContext(ctx context.Context, forwardId string) (*GetForwardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetForwardRequestWithContext(ctx context.Context, forwardId string, opts ...client.RequestOption) (*GetForwardResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) CreateSecret(request CreateSecretRequest) (*SingleSecretRespons
 	return c.CreateSecretWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateSecretWithContext(ctx context.Context, request CreateSecretRequest) (*SingleSecretResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateSecretWithContext(ctx context.Context, request CreateSecretRequest, opts ...client.RequestOption) (*SingleSecretResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +84,9 @@ func (c *Client) ListSecrets() (*ListSecretsResponse, error) {
 	return c.ListSecretsWithContext(context.Background())
 }
 
-func (c *Client) ListSecretsWithContext(ctx context.Context) (*ListSecretsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) ListSecretsWithContext(ctx context.Context, opts ...client.RequestOption) (*ListSecretsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +104,9 @@ func (c *Client) UpdateSecret(name string, request UpdateSecretRequest) (*Single
 	return c.UpdateSecretWithContext(context.Background(), name, request)
 }
 
-func (c *Client) UpdateSecretWithContext(ctx context.Context, name string, request UpdateSecretRequest) (*SingleSecretResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) UpdateSecretWithContext(ctx context.Context, name string, request UpdateSecretRequest, opts ...client.RequestOption) (*SingleSecretResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +124,9 @@ func (c *Client) DeleteSecret(name string) (*common.MetadataResponse, error) {
 	return c.DeleteSecretWithContext(context.Background(), name)
 }
 
-func (c *Client) DeleteSecretWithContext(ctx context.Context, name string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) DeleteSecretWithContext(ctx context.Context, name string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -24,8 +24,9 @@ func (c *Client) CreateAmlScreening(request CreateAmlScreeningRequest) (*AmlScre
 	return c.CreateAmlScreeningWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateAmlScreeningWithContext(ctx context.Context, request CreateAmlScreeningRequest) (*AmlScreeningResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateAmlScreeningWithContext(ctx context.Context, request CreateAmlScreeningRequest, opts ...client.RequestOption) (*AmlScreeningResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) GetAmlScreening(screeningId string) (*AmlScreeningResponse, err
 	return c.GetAmlScreeningWithContext(context.Background(), screeningId)
 }
 
-func (c *Client) GetAmlScreeningWithContext(ctx context.Context, screeningId string) (*AmlScreeningResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetAmlScreeningWithContext(ctx context.Context, screeningId string, opts ...client.RequestOption) (*AmlScreeningResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -24,8 +24,9 @@ func (c *Client) CreateApplicant(request CreateApplicantRequest) (*ApplicantResp
 	return c.CreateApplicantWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateApplicantWithContext(ctx context.Context, request CreateApplicantRequest) (*ApplicantResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateApplicantWithContext(ctx context.Context, request CreateApplicantRequest, opts ...client.RequestOption) (*ApplicantResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) GetApplicant(applicantId string) (*ApplicantResponse, error) {
 	return c.GetApplicantWithContext(context.Background(), applicantId)
 }
 
-func (c *Client) GetApplicantWithContext(ctx context.Context, applicantId string) (*ApplicantResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetApplicantWithContext(ctx context.Context, applicantId string, opts ...client.RequestOption) (*ApplicantResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) UpdateApplicant(applicantId string, request UpdateApplicantRequ
 	return c.UpdateApplicantWithContext(context.Background(), applicantId, request)
 }
 
-func (c *Client) UpdateApplicantWithContext(ctx context.Context, applicantId string, request UpdateApplicantRequest) (*ApplicantResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) UpdateApplicantWithContext(ctx context.Context, applicantId string, request UpdateApplicantRequest, opts ...client.RequestOption) (*ApplicantResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +84,9 @@ func (c *Client) AnonymizeApplicant(applicantId string) (*ApplicantResponse, err
 	return c.AnonymizeApplicantWithContext(context.Background(), applicantId)
 }
 
-func (c *Client) AnonymizeApplicantWithContext(ctx context.Context, applicantId string) (*ApplicantResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) AnonymizeApplicantWithContext(ctx context.Context, applicantId string, opts ...client.RequestOption) (*ApplicantResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -24,8 +24,9 @@ func (c *Client) CreateFaceAuthentication(request CreateFaceAuthenticationReques
 	return c.CreateFaceAuthenticationWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateFaceAuthenticationWithContext(ctx context.Context, request CreateFaceAuthenticationRequest) (*FaceAuthenticationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateFaceAuthenticationWithContext(ctx context.Context, request CreateFaceAuthenticationRequest, opts ...client.RequestOption) (*FaceAuthenticationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) GetFaceAuthentication(faceAuthenticationId string) (*FaceAuthen
 	return c.GetFaceAuthenticationWithContext(context.Background(), faceAuthenticationId)
 }
 
-func (c *Client) GetFaceAuthenticationWithContext(ctx context.Context, faceAuthenticationId string) (*FaceAuthenticationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetFaceAuthenticationWithContext(ctx context.Context, faceAuthenticationId string, opts ...client.RequestOption) (*FaceAuthenticationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) AnonymizeFaceAuthentication(faceAuthenticationId string) (*Face
 	return c.AnonymizeFaceAuthenticationWithContext(context.Background(), faceAuthenticationId)
 }
 
-func (c *Client) AnonymizeFaceAuthenticationWithContext(ctx context.Context, faceAuthenticationId string) (*FaceAuthenticationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) AnonymizeFaceAuthenticationWithContext(ctx context.Context, faceAuthenticationId string, opts ...client.RequestOption) (*FaceAuthenticationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +84,9 @@ func (c *Client) CreateFaceAuthenticationAttempt(faceAuthenticationId string, re
 	return c.CreateFaceAuthenticationAttemptWithContext(context.Background(), faceAuthenticationId, request)
 }
 
-func (c *Client) CreateFaceAuthenticationAttemptWithContext(ctx context.Context, faceAuthenticationId string, request CreateFaceAuthenticationAttemptRequest) (*FaceAuthenticationAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateFaceAuthenticationAttemptWithContext(ctx context.Context, faceAuthenticationId string, request CreateFaceAuthenticationAttemptRequest, opts ...client.RequestOption) (*FaceAuthenticationAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +104,9 @@ func (c *Client) GetFaceAuthenticationAttempts(faceAuthenticationId string) (*Fa
 	return c.GetFaceAuthenticationAttemptsWithContext(context.Background(), faceAuthenticationId)
 }
 
-func (c *Client) GetFaceAuthenticationAttemptsWithContext(ctx context.Context, faceAuthenticationId string) (*FaceAuthenticationAttemptsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetFaceAuthenticationAttemptsWithContext(ctx context.Context, faceAuthenticationId string, opts ...client.RequestOption) (*FaceAuthenticationAttemptsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +124,9 @@ func (c *Client) GetFaceAuthenticationAttempt(faceAuthenticationId, attemptId st
 	return c.GetFaceAuthenticationAttemptWithContext(context.Background(), faceAuthenticationId, attemptId)
 }
 
-func (c *Client) GetFaceAuthenticationAttemptWithContext(ctx context.Context, faceAuthenticationId, attemptId string) (*FaceAuthenticationAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetFaceAuthenticationAttemptWithContext(ctx context.Context, faceAuthenticationId, attemptId string, opts ...client.RequestOption) (*FaceAuthenticationAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
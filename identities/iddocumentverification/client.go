@@ -24,8 +24,9 @@ func (c *Client) CreateIdDocumentVerification(request CreateIdDocumentVerificati
 	return c.CreateIdDocumentVerificationWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateIdDocumentVerificationWithContext(ctx context.Context, request CreateIdDocumentVerificationRequest) (*IdDocumentVerificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateIdDocumentVerificationWithContext(ctx context.Context, request CreateIdDocumentVerificationRequest, opts ...client.RequestOption) (*IdDocumentVerificationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) GetIdDocumentVerification(verificationId string) (*IdDocumentVe
 	return c.GetIdDocumentVerificationWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) GetIdDocumentVerificationWithContext(ctx context.Context, verificationId string) (*IdDocumentVerificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdDocumentVerificationWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdDocumentVerificationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) AnonymizeIdDocumentVerification(verificationId string) (*IdDocu
 	return c.AnonymizeIdDocumentVerificationWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) AnonymizeIdDocumentVerificationWithContext(ctx context.Context, verificationId string) (*IdDocumentVerificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) AnonymizeIdDocumentVerificationWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdDocumentVerificationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +84,9 @@ func (c *Client) CreateIdDocumentVerificationAttempt(verificationId string, requ
 	return c.CreateIdDocumentVerificationAttemptWithContext(context.Background(), verificationId, request)
 }
 
-func (c *Client) CreateIdDocumentVerificationAttemptWithContext(ctx context.Context, verificationId string, request CreateIdDocumentVerificationAttemptRequest) (*IdDocumentVerificationAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateIdDocumentVerificationAttemptWithContext(ctx context.Context, verificationId string, request CreateIdDocumentVerificationAttemptRequest, opts ...client.RequestOption) (*IdDocumentVerificationAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +104,9 @@ func (c *Client) GetIdDocumentVerificationAttempts(verificationId string) (*IdDo
 	return c.GetIdDocumentVerificationAttemptsWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) GetIdDocumentVerificationAttemptsWithContext(ctx context.Context, verificationId string) (*IdDocumentVerificationAttemptsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdDocumentVerificationAttemptsWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdDocumentVerificationAttemptsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +124,9 @@ func (c *Client) GetIdDocumentVerificationAttempt(verificationId, attemptId stri
 	return c.GetIdDocumentVerificationAttemptWithContext(context.Background(), verificationId, attemptId)
 }
 
-func (c *Client) GetIdDocumentVerificationAttemptWithContext(ctx context.Context, verificationId, attemptId string) (*IdDocumentVerificationAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdDocumentVerificationAttemptWithContext(ctx context.Context, verificationId, attemptId string, opts ...client.RequestOption) (*IdDocumentVerificationAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -138,8 +144,9 @@ func (c *Client) GetIdDocumentVerificationReport(verificationId string) (*IdDocu
 	return c.GetIdDocumentVerificationReportWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) GetIdDocumentVerificationReportWithContext(ctx context.Context, verificationId string) (*IdDocumentVerificationReportResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdDocumentVerificationReportWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdDocumentVerificationReportResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package iddocumentverification
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+// IdDocumentVerificationAttemptsPager pages through the attempts of an id document verification. The endpoint takes no
+// paging parameters and returns every attempt in a single page.
+func (c *Client) IdDocumentVerificationAttemptsPager(verificationId string, opts ...client.RequestOption) *common.Pager[IdDocumentVerificationAttemptResponse] {
+	return common.NewPager(func(ctx context.Context, _ string) (common.Page[IdDocumentVerificationAttemptResponse], error) {
+		response, err := c.GetIdDocumentVerificationAttemptsWithContext(ctx, verificationId, opts...)
+		if err != nil {
+			return common.Page[IdDocumentVerificationAttemptResponse]{}, err
+		}
+		return common.Page[IdDocumentVerificationAttemptResponse]{Items: response.Data}, nil
+	})
+}
+
+func (c *Client) AllIdDocumentVerificationAttempts(ctx context.Context, verificationId string, opts ...client.RequestOption) iter.Seq2[IdDocumentVerificationAttemptResponse, error] {
+	return c.IdDocumentVerificationAttemptsPager(verificationId, opts...).All(ctx)
+}
@@ -24,8 +24,9 @@ func (c *Client) CreateIdentityVerificationAndAttempt(request CreateIdentityVeri
 	return c.CreateIdentityVerificationAndAttemptWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateIdentityVerificationAndAttemptWithContext(ctx context.Context, request CreateIdentityVerificationAndAttemptRequest) (*IdentityVerificationAndAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateIdentityVerificationAndAttemptWithContext(ctx context.Context, request CreateIdentityVerificationAndAttemptRequest, opts ...client.RequestOption) (*IdentityVerificationAndAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) CreateIdentityVerification(request CreateIdentityVerificationRe
 	return c.CreateIdentityVerificationWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateIdentityVerificationWithContext(ctx context.Context, request CreateIdentityVerificationRequest) (*IdentityVerificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateIdentityVerificationWithContext(ctx context.Context, request CreateIdentityVerificationRequest, opts ...client.RequestOption) (*IdentityVerificationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) GetIdentityVerification(verificationId string) (*IdentityVerifi
 	return c.GetIdentityVerificationWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) GetIdentityVerificationWithContext(ctx context.Context, verificationId string) (*IdentityVerificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdentityVerificationWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdentityVerificationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +84,9 @@ func (c *Client) AnonymizeIdentityVerification(verificationId string) (*Identity
 	return c.AnonymizeIdentityVerificationWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) AnonymizeIdentityVerificationWithContext(ctx context.Context, verificationId string) (*IdentityVerificationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) AnonymizeIdentityVerificationWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdentityVerificationResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +104,9 @@ func (c *Client) CreateIdentityVerificationAttempt(verificationId string, reques
 	return c.CreateIdentityVerificationAttemptWithContext(context.Background(), verificationId, request)
 }
 
-func (c *Client) CreateIdentityVerificationAttemptWithContext(ctx context.Context, verificationId string, request CreateIdentityVerificationAttemptRequest) (*IdentityVerificationAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreateIdentityVerificationAttemptWithContext(ctx context.Context, verificationId string, request CreateIdentityVerificationAttemptRequest, opts ...client.RequestOption) (*IdentityVerificationAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +124,9 @@ func (c *Client) GetIdentityVerificationAttempts(verificationId string) (*Identi
 	return c.GetIdentityVerificationAttemptsWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) GetIdentityVerificationAttemptsWithContext(ctx context.Context, verificationId string) (*IdentityVerificationAttemptsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdentityVerificationAttemptsWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdentityVerificationAttemptsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -138,8 +144,9 @@ func (c *Client) GetIdentityVerificationAttempt(verificationId, attemptId string
 	return c.GetIdentityVerificationAttemptWithContext(context.Background(), verificationId, attemptId)
 }
 
-func (c *Client) GetIdentityVerificationAttemptWithContext(ctx context.Context, verificationId, attemptId string) (*IdentityVerificationAttemptResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdentityVerificationAttemptWithContext(ctx context.Context, verificationId, attemptId string, opts ...client.RequestOption) (*IdentityVerificationAttemptResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -157,8 +164,9 @@ func (c *Client) GetIdentityVerificationReport(verificationId string) (*Identity
 	return c.GetIdentityVerificationReportWithContext(context.Background(), verificationId)
 }
 
-func (c *Client) GetIdentityVerificationReportWithContext(ctx context.Context, verificationId string) (*IdentityVerificationReportResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetIdentityVerificationReportWithContext(ctx context.Context, verificationId string, opts ...client.RequestOption) (*IdentityVerificationReportResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package identityverification
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+// IdentityVerificationAttemptsPager pages through the attempts of an identity verification. The endpoint takes no
+// paging parameters and returns every attempt in a single page.
+func (c *Client) IdentityVerificationAttemptsPager(verificationId string, opts ...client.RequestOption) *common.Pager[IdentityVerificationAttemptResponse] {
+	return common.NewPager(func(ctx context.Context, _ string) (common.Page[IdentityVerificationAttemptResponse], error) {
+		response, err := c.GetIdentityVerificationAttemptsWithContext(ctx, verificationId, opts...)
+		if err != nil {
+			return common.Page[IdentityVerificationAttemptResponse]{}, err
+		}
+		return common.Page[IdentityVerificationAttemptResponse]{Items: response.Data}, nil
+	})
+}
+
+func (c *Client) AllIdentityVerificationAttempts(ctx context.Context, verificationId string, opts ...client.RequestOption) iter.Seq2[IdentityVerificationAttemptResponse, error] {
+	return c.IdentityVerificationAttemptsPager(verificationId, opts...).All(ctx)
+}
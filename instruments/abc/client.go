@@ -24,8 +24,9 @@ func (c *Client) Create(request CreateInstrumentRequest) (*CreateInstrumentRespo
 	return c.CreateWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateWithContext(ctx context.Context, request CreateInstrumentRequest) (*CreateInstrumentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CreateWithContext(ctx context.Context, request CreateInstrumentRequest, opts ...client.RequestOption) (*CreateInstrumentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +51,9 @@ func (c *Client) Get(instrumentId string) (*GetInstrumentResponse, error) {
 	return c.GetWithContext(context.Background(), instrumentId)
 }
 
-func (c *Client) GetWithContext(ctx context.Context, instrumentId string) (*GetInstrumentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetWithContext(ctx context.Context, instrumentId string, opts ...client.RequestOption) (*GetInstrumentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +71,9 @@ func (c *Client) Update(instrumentId string, request UpdateInstrumentRequest) (*
 	return c.UpdateWithContext(context.Background(), instrumentId, request)
 }
 
-func (c *Client) UpdateWithContext(ctx context.Context, instrumentId string, request UpdateInstrumentRequest) (*UpdateInstrumentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) UpdateWithContext(ctx context.Context, instrumentId string, request UpdateInstrumentRequest, opts ...client.RequestOption) (*UpdateInstrumentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +97,9 @@ func (c *Client) Delete(instrumentId string) (*common.MetadataResponse, error) {
 	return c.DeleteWithContext(context.Background(), instrumentId)
 }
 
-func (c *Client) DeleteWithContext(ctx context.Context, instrumentId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) DeleteWithContext(ctx context.Context, instrumentId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -28,8 +28,10 @@ func (c *Client) Create(request CreateInstrumentRequest) (*CreateInstrumentRespo
 func (c *Client) CreateWithContext(
 	ctx context.Context,
 	request CreateInstrumentRequest,
+	opts ...client.RequestOption,
 ) (*CreateInstrumentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +49,9 @@ func (c *Client) Get(instrumentId string) (*GetInstrumentResponse, error) {
 	return c.GetWithContext(context.Background(), instrumentId)
 }
 
-func (c *Client) GetWithContext(ctx context.Context, instrumentId string) (*GetInstrumentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetWithContext(ctx context.Context, instrumentId string, opts ...client.RequestOption) (*GetInstrumentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +78,10 @@ func (c *Client) GetBankAccountFieldFormattingWithContext(
 	country string,
 	currency string,
 	query QueryBankAccountFormatting,
+	opts ...client.RequestOption,
 ) (*GetBankAccountFieldFormattingResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -103,8 +108,10 @@ func (c *Client) UpdateWithContext(
 	ctx context.Context,
 	instrumentId string,
 	request UpdateInstrumentRequest,
+	opts ...client.RequestOption,
 ) (*UpdateInstrumentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +129,9 @@ func (c *Client) Delete(instrumentId string) (*common.MetadataResponse, error) {
 	return c.DeleteWithContext(context.Background(), instrumentId)
 }
 
-func (c *Client) DeleteWithContext(ctx context.Context, instrumentId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) DeleteWithContext(ctx context.Context, instrumentId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -141,8 +149,9 @@ func (c *Client) RevokeInstrument(instrumentId string) (*common.MetadataResponse
 	return c.RevokeInstrumentWithContext(context.Background(), instrumentId)
 }
 
-func (c *Client) RevokeInstrumentWithContext(ctx context.Context, instrumentId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RevokeInstrumentWithContext(ctx context.Context, instrumentId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +32,8 @@ func (c *Client) RequestCardholderToken(request CardholderTokenRequest) (*Cardho
 
 // RequestCardholderTokenWithContext is like RequestCardholderToken but accepts a context for
 // cancellation and deadline propagation.
-func (c *Client) RequestCardholderTokenWithContext(ctx context.Context, request CardholderTokenRequest) (*CardholderTokenResponse, error) {
+func (c *Client) RequestCardholderTokenWithContext(ctx context.Context, request CardholderTokenRequest, opts ...client.RequestOption) (*CardholderTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
 	formData := url.Values{}
 	formData.Set("grant_type", request.GrantType)
 	formData.Set("client_id", request.ClientId)
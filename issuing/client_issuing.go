@@ -69,8 +69,10 @@ func (c *Client) CreateCardholder(request cardholders.CardholderRequest) (*cardh
 func (c *Client) CreateCardholderWithContext(
 	ctx context.Context,
 	request cardholders.CardholderRequest,
+	opts ...client.RequestOption,
 ) (*cardholders.CardholderResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +98,10 @@ func (c *Client) GetCardholder(cardholderId string) (*cardholders.CardholderDeta
 func (c *Client) GetCardholderWithContext(
 	ctx context.Context,
 	cardholderId string,
+	opts ...client.RequestOption,
 ) (*cardholders.CardholderDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -121,8 +125,10 @@ func (c *Client) GetCardholderCards(cardholderId string) (*cardholders.Cardholde
 func (c *Client) GetCardholderCardsWithContext(
 	ctx context.Context,
 	cardholderId string,
+	opts ...client.RequestOption,
 ) (*cardholders.CardholderCardsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -146,8 +152,10 @@ func (c *Client) CreateCard(request cards.CardRequest) (*cards.CardResponse, err
 func (c *Client) CreateCardWithContext(
 	ctx context.Context,
 	request cards.CardRequest,
+	opts ...client.RequestOption,
 ) (*cards.CardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -170,8 +178,9 @@ func (c *Client) GetCardDetails(cardId string) (*cards.CardDetailsResponse, erro
 	return c.GetCardDetailsWithContext(context.Background(), cardId)
 }
 
-func (c *Client) GetCardDetailsWithContext(ctx context.Context, cardId string) (*cards.CardDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetCardDetailsWithContext(ctx context.Context, cardId string, opts ...client.RequestOption) (*cards.CardDetailsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -196,8 +205,10 @@ func (c *Client) EnrollThreeDSWithContext(
 	ctx context.Context,
 	cardId string,
 	enrollmentRequest cards.ThreeDSEnrollmentRequest,
+	opts ...client.RequestOption,
 ) (*cards.ThreeDSEnrollmentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +238,10 @@ func (c *Client) UpdateThreeDSWithContext(
 	ctx context.Context,
 	cardId string,
 	threeDSUpdateRequest cards.ThreeDSUpdateRequest,
+	opts ...client.RequestOption,
 ) (*cards.ThreeDSUpdateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -253,8 +266,10 @@ func (c *Client) GetCardThreeDSDetails(cardId string) (*cards.ThreeDSEnrollmentD
 func (c *Client) GetCardThreeDSDetailsWithContext(
 	ctx context.Context,
 	cardId string,
+	opts ...client.RequestOption,
 ) (*cards.ThreeDSEnrollmentDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -278,8 +293,10 @@ func (c *Client) ActivateCard(cardId string) (*cards.ActivateCardResponse, error
 func (c *Client) ActivateCardWithContext(
 	ctx context.Context,
 	cardId string,
+	opts ...client.RequestOption,
 ) (*cards.ActivateCardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +326,10 @@ func (c *Client) GetCardCredentialsWithContext(
 	ctx context.Context,
 	cardId string,
 	credentialsQuery cards.CardCredentialsQuery,
+	opts ...client.RequestOption,
 ) (*cards.CardCredentialsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -340,8 +359,10 @@ func (c *Client) RevokeCardWithContext(
 	ctx context.Context,
 	cardId string,
 	revokeCardRequest cards.RevokeCardRequest,
+	opts ...client.RequestOption,
 ) (*cards.RevokeCardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -371,8 +392,10 @@ func (c *Client) SuspendCardWithContext(
 	ctx context.Context,
 	cardId string,
 	suspendCardRequest cards.SuspendCardRequest,
+	opts ...client.RequestOption,
 ) (*cards.SuspendCardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -402,8 +425,10 @@ func (c *Client) UpdateCardWithContext(
 	ctx context.Context,
 	cardId string,
 	request cards.CardUpdateRequest,
+	opts ...client.RequestOption,
 ) (*cards.CardUpdateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -428,8 +453,10 @@ func (c *Client) CreateControl(request controls.CardControlRequest) (*controls.C
 func (c *Client) CreateControlWithContext(
 	ctx context.Context,
 	request controls.CardControlRequest,
+	opts ...client.RequestOption,
 ) (*controls.CardControlResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -455,8 +482,10 @@ func (c *Client) GetCardControls(query controls.CardControlsQuery) (*controls.Ca
 func (c *Client) GetCardControlsWithContext(
 	ctx context.Context,
 	query controls.CardControlsQuery,
+	opts ...client.RequestOption,
 ) (*controls.CardControlsQueryResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -476,8 +505,10 @@ func (c *Client) GetCardControlDetails(controlId string) (*controls.CardControlR
 func (c *Client) GetCardControlDetailsWithContext(
 	ctx context.Context,
 	controlId string,
+	opts ...client.RequestOption,
 ) (*controls.CardControlResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -505,8 +536,10 @@ func (c *Client) UpdateCardControlWithContext(
 	ctx context.Context,
 	controlId string,
 	updateCardControlRequest controls.UpdateCardControlRequest,
+	opts ...client.RequestOption,
 ) (*controls.CardControlResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -532,8 +565,10 @@ func (c *Client) RemoveCardControl(controlId string) (*common.IdResponse, error)
 func (c *Client) RemoveCardControlWithContext(
 	ctx context.Context,
 	controlId string,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -559,8 +594,10 @@ func (c *Client) SimulateAuthorization(
 func (c *Client) SimulateAuthorizationWithContext(
 	ctx context.Context,
 	request testing.CardAuthorizationRequest,
+	opts ...client.RequestOption,
 ) (*testing.CardAuthorizationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -590,8 +627,10 @@ func (c *Client) SimulateIncrementWithContext(
 	ctx context.Context,
 	transactionId string,
 	request testing.CardSimulationRequest,
+	opts ...client.RequestOption,
 ) (*testing.CardSimulationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -621,8 +660,10 @@ func (c *Client) SimulateClearingWithContext(
 	ctx context.Context,
 	transactionId string,
 	request testing.CardSimulationRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -652,8 +693,10 @@ func (c *Client) SimulateReversalWithContext(
 	ctx context.Context,
 	transactionId string,
 	request testing.CardSimulationRequest,
+	opts ...client.RequestOption,
 ) (*testing.CardSimulationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -683,8 +726,10 @@ func (c *Client) UpdateCardholderWithContext(
 	ctx context.Context,
 	cardholderId string,
 	request cardholders.CardholderRequest,
+	opts ...client.RequestOption,
 ) (*cardholders.CardholderUpdateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -710,8 +755,10 @@ func (c *Client) RenewCardWithContext(
 	ctx context.Context,
 	cardId string,
 	request cards.RenewCardRequest,
+	opts ...client.RequestOption,
 ) (*cards.RenewCardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -741,8 +788,10 @@ func (c *Client) ScheduleCardRevocationWithContext(
 	ctx context.Context,
 	cardId string,
 	request cards.ScheduleRevocationRequest,
+	opts ...client.RequestOption,
 ) (*cards.ScheduleRevocationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -768,8 +817,10 @@ func (c *Client) DeleteScheduledRevocation(cardId string) (*cards.ScheduleRevoca
 func (c *Client) DeleteScheduledRevocationWithContext(
 	ctx context.Context,
 	cardId string,
+	opts ...client.RequestOption,
 ) (*cards.ScheduleRevocationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -795,8 +846,10 @@ func (c *Client) GetControlGroups(
 func (c *Client) GetControlGroupsWithContext(
 	ctx context.Context,
 	query controlgroups.ControlGroupsQuery,
+	opts ...client.RequestOption,
 ) (*controlgroups.ControlGroupsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -821,8 +874,10 @@ func (c *Client) CreateControlGroup(
 func (c *Client) CreateControlGroupWithContext(
 	ctx context.Context,
 	request controlgroups.CreateControlGroupRequest,
+	opts ...client.RequestOption,
 ) (*controlgroups.ControlGroupResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -848,8 +903,10 @@ func (c *Client) GetControlGroupDetails(controlGroupId string) (*controlgroups.C
 func (c *Client) GetControlGroupDetailsWithContext(
 	ctx context.Context,
 	controlGroupId string,
+	opts ...client.RequestOption,
 ) (*controlgroups.ControlGroupResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -873,8 +930,10 @@ func (c *Client) RemoveControlGroup(controlGroupId string) (*common.MetadataResp
 func (c *Client) RemoveControlGroupWithContext(
 	ctx context.Context,
 	controlGroupId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -900,8 +959,10 @@ func (c *Client) GetAllControlProfiles(
 func (c *Client) GetAllControlProfilesWithContext(
 	ctx context.Context,
 	query controlprofiles.ControlProfilesQuery,
+	opts ...client.RequestOption,
 ) (*controlprofiles.ControlProfilesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -926,8 +987,10 @@ func (c *Client) CreateControlProfile(
 func (c *Client) CreateControlProfileWithContext(
 	ctx context.Context,
 	request controlprofiles.ControlProfileRequest,
+	opts ...client.RequestOption,
 ) (*controlprofiles.ControlProfileResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -955,8 +1018,10 @@ func (c *Client) GetControlProfileDetails(
 func (c *Client) GetControlProfileDetailsWithContext(
 	ctx context.Context,
 	controlProfileId string,
+	opts ...client.RequestOption,
 ) (*controlprofiles.ControlProfileResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -984,8 +1049,10 @@ func (c *Client) UpdateControlProfileWithContext(
 	ctx context.Context,
 	controlProfileId string,
 	request controlprofiles.ControlProfileRequest,
+	opts ...client.RequestOption,
 ) (*controlprofiles.ControlProfileResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1010,8 +1077,10 @@ func (c *Client) RemoveControlProfile(controlProfileId string) (*common.Metadata
 func (c *Client) RemoveControlProfileWithContext(
 	ctx context.Context,
 	controlProfileId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1037,8 +1106,10 @@ func (c *Client) AddTargetToControlProfile(
 func (c *Client) AddTargetToControlProfileWithContext(
 	ctx context.Context,
 	controlProfileId, targetId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1066,8 +1137,10 @@ func (c *Client) RemoveTargetFromControlProfile(
 func (c *Client) RemoveTargetFromControlProfileWithContext(
 	ctx context.Context,
 	controlProfileId, targetId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1093,8 +1166,10 @@ func (c *Client) GetDigitalCard(digitalCardId string) (*digitalcards.GetDigitalC
 func (c *Client) GetDigitalCardWithContext(
 	ctx context.Context,
 	digitalCardId string,
+	opts ...client.RequestOption,
 ) (*digitalcards.GetDigitalCardResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1122,8 +1197,10 @@ func (c *Client) CreateDisputeWithContext(
 	ctx context.Context,
 	request disputes.CreateDisputeRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*disputes.IssuingDisputeResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1149,8 +1226,10 @@ func (c *Client) GetDispute(disputeId string) (*disputes.IssuingDisputeResponse,
 func (c *Client) GetDisputeWithContext(
 	ctx context.Context,
 	disputeId string,
+	opts ...client.RequestOption,
 ) (*disputes.IssuingDisputeResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1175,8 +1254,10 @@ func (c *Client) CancelDisputeWithContext(
 	ctx context.Context,
 	disputeId string,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1208,8 +1289,10 @@ func (c *Client) EscalateDisputeWithContext(
 	disputeId string,
 	request disputes.EscalateDisputeRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1239,8 +1322,10 @@ func (c *Client) SimulateRefundWithContext(
 	ctx context.Context,
 	transactionId string,
 	request testing.SimulateRefundRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1268,8 +1353,10 @@ func (c *Client) SimulateOobAuthentication(
 func (c *Client) SimulateOobAuthenticationWithContext(
 	ctx context.Context,
 	request testing.SimulateOobAuthenticationRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1297,8 +1384,10 @@ func (c *Client) GetListTransactions(
 func (c *Client) GetListTransactionsWithContext(
 	ctx context.Context,
 	query transactions.TransactionsQuery,
+	opts ...client.RequestOption,
 ) (*transactions.TransactionsListResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1321,8 +1410,10 @@ func (c *Client) GetSingleTransaction(transactionId string) (*transactions.Trans
 func (c *Client) GetSingleTransactionWithContext(
 	ctx context.Context,
 	transactionId string,
+	opts ...client.RequestOption,
 ) (*transactions.TransactionResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
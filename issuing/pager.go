@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package issuing
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	transactions "github.com/checkout/checkout-sdk-go/v2/issuing/transactions"
+)
+
+// TransactionsPager pages through the issuing transactions matching the query, starting at query.Skip.
+func (c *Client) TransactionsPager(
+	query transactions.TransactionsQuery,
+	opts ...client.RequestOption,
+) *common.Pager[transactions.TransactionResponse] {
+	return common.NewOffsetPager(query.Skip, query.Limit, func(ctx context.Context, skip, limit int) ([]transactions.TransactionResponse, int, error) {
+		pageQuery := query
+		pageQuery.Skip, pageQuery.Limit = skip, limit
+		response, err := c.GetListTransactionsWithContext(ctx, pageQuery, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		total := 0
+		if response.TotalCount != nil {
+			total = *response.TotalCount
+		}
+		return response.Data, total, nil
+	})
+}
+
+func (c *Client) AllTransactions(
+	ctx context.Context,
+	query transactions.TransactionsQuery,
+	opts ...client.RequestOption,
+) iter.Seq2[transactions.TransactionResponse, error] {
+	return c.TransactionsPager(query, opts...).All(ctx)
+}
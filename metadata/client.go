@@ -27,8 +27,10 @@ func (c *Client) RequestCardMetadata(request CardMetadataRequest) (*CardMetadata
 func (c *Client) RequestCardMetadataWithContext(
 	ctx context.Context,
 	request CardMetadataRequest,
+	opts ...client.RequestOption,
 ) (*CardMetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,192 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+const (
+	requestsTotal   = "checkout_sdk_requests_total"
+	errorsTotal     = "checkout_sdk_errors_total"
+	retriesTotal    = "checkout_sdk_retries_total"
+	requestDuration = "checkout_sdk_request_duration_seconds"
+
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+type (
+	// Prometheus collects the SDK metrics in memory and exposes them in the Prometheus text format.
+	// It implements both configuration.Metrics and http.Handler, so it can be mounted on a /metrics endpoint.
+	Prometheus struct {
+		buckets   []float64
+		mutex     sync.Mutex
+		requests  map[labels]uint64
+		errors    map[labels]uint64
+		retries   map[labels]uint64
+		durations map[labels]*histogram
+	}
+
+	labels struct {
+		method string
+		path   string
+		status string
+		class  string
+	}
+
+	histogram struct {
+		counts []uint64
+		sum    float64
+		count  uint64
+	}
+)
+
+func NewPrometheus() *Prometheus {
+	return NewPrometheusWithBuckets(DefaultBuckets)
+}
+
+func NewPrometheusWithBuckets(buckets []float64) *Prometheus {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	return &Prometheus{
+		buckets:   sorted,
+		requests:  make(map[labels]uint64),
+		errors:    make(map[labels]uint64),
+		retries:   make(map[labels]uint64),
+		durations: make(map[labels]*histogram),
+	}
+}
+
+func (p *Prometheus) ObserveRequest(observation configuration.RequestObservation) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	route := labels{method: observation.Method, path: observation.PathTemplate}
+
+	status := route
+	status.status = strconv.Itoa(observation.StatusCode)
+	p.requests[status]++
+
+	if observation.ErrorClass != configuration.NoError {
+		class := route
+		class.class = string(observation.ErrorClass)
+		p.errors[class]++
+	}
+
+	h, ok := p.durations[route]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.durations[route] = h
+	}
+	seconds := observation.Duration.Seconds()
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+func (p *Prometheus) ObserveRetry(method string, pathTemplate string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.retries[labels{method: method, path: pathTemplate}]++
+}
+
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", prometheusContentType)
+	_ = p.Write(w)
+}
+
+// Write renders every metric in the Prometheus text exposition format.
+func (p *Prometheus) Write(w io.Writer) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	out := bufio.NewWriter(w)
+
+	writeHeader(out, requestsTotal, "counter", "Total number of calls made to the Checkout API.")
+	for _, l := range sortedLabels(p.requests) {
+		fmt.Fprintf(out, "%s{method=%s,path=%s,status=%s} %d\n", requestsTotal, quote(l.method), quote(l.path), quote(l.status), p.requests[l])
+	}
+
+	writeHeader(out, errorsTotal, "counter", "Total number of failed calls made to the Checkout API, by error class.")
+	for _, l := range sortedLabels(p.errors) {
+		fmt.Fprintf(out, "%s{method=%s,path=%s,class=%s} %d\n", errorsTotal, quote(l.method), quote(l.path), quote(l.class), p.errors[l])
+	}
+
+	writeHeader(out, retriesTotal, "counter", "Total number of retried attempts.")
+	for _, l := range sortedLabels(p.retries) {
+		fmt.Fprintf(out, "%s{method=%s,path=%s} %d\n", retriesTotal, quote(l.method), quote(l.path), p.retries[l])
+	}
+
+	writeHeader(out, requestDuration, "histogram", "Duration of the calls made to the Checkout API, including retries.")
+	routes := make([]labels, 0, len(p.durations))
+	for l := range p.durations {
+		routes = append(routes, l)
+	}
+	sortLabels(routes)
+	for _, l := range routes {
+		h := p.durations[l]
+		route := fmt.Sprintf("method=%s,path=%s", quote(l.method), quote(l.path))
+		for i, bound := range p.buckets {
+			fmt.Fprintf(out, "%s_bucket{%s,le=\"%s\"} %d\n", requestDuration, route, formatFloat(bound), h.counts[i])
+		}
+		fmt.Fprintf(out, "%s_bucket{%s,le=\"+Inf\"} %d\n", requestDuration, route, h.count)
+		fmt.Fprintf(out, "%s_sum{%s} %s\n", requestDuration, route, formatFloat(h.sum))
+		fmt.Fprintf(out, "%s_count{%s} %d\n", requestDuration, route, h.count)
+	}
+
+	return out.Flush()
+}
+
+func writeHeader(w io.Writer, name string, kind string, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func sortedLabels(series map[labels]uint64) []labels {
+	keys := make([]labels, 0, len(series))
+	for l := range series {
+		keys = append(keys, l)
+	}
+	sortLabels(keys)
+	return keys
+}
+
+func sortLabels(keys []labels) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.path != b.path {
+			return a.path < b.path
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		if a.status != b.status {
+			return a.status < b.status
+		}
+		return a.class < b.class
+	})
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quote(value string) string {
+	return `"` + labelEscaper.Replace(value) + `"`
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
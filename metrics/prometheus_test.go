@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+func TestPrometheus_Write(t *testing.T) {
+	p := NewPrometheusWithBuckets([]float64{0.5, 0.1})
+
+	p.ObserveRequest(configuration.RequestObservation{
+		Method:       http.MethodGet,
+		PathTemplate: "/payments/{id}",
+		StatusCode:   http.StatusOK,
+		Duration:     50 * time.Millisecond,
+	})
+	p.ObserveRequest(configuration.RequestObservation{
+		Method:       http.MethodGet,
+		PathTemplate: "/payments/{id}",
+		StatusCode:   http.StatusServiceUnavailable,
+		ErrorClass:   configuration.ServerError,
+		Duration:     300 * time.Millisecond,
+	})
+	p.ObserveRetry(http.MethodGet, "/payments/{id}")
+
+	var out bytes.Buffer
+	assert.Nil(t, p.Write(&out))
+
+	expected := `# HELP checkout_sdk_requests_total Total number of calls made to the Checkout API.
+# TYPE checkout_sdk_requests_total counter
+checkout_sdk_requests_total{method="GET",path="/payments/{id}",status="200"} 1
+checkout_sdk_requests_total{method="GET",path="/payments/{id}",status="503"} 1
+# HELP checkout_sdk_errors_total Total number of failed calls made to the Checkout API, by error class.
+# TYPE checkout_sdk_errors_total counter
+checkout_sdk_errors_total{method="GET",path="/payments/{id}",class="server_error"} 1
+# HELP checkout_sdk_retries_total Total number of retried attempts.
+# TYPE checkout_sdk_retries_total counter
+checkout_sdk_retries_total{method="GET",path="/payments/{id}"} 1
+# HELP checkout_sdk_request_duration_seconds Duration of the calls made to the Checkout API, including retries.
+# TYPE checkout_sdk_request_duration_seconds histogram
+checkout_sdk_request_duration_seconds_bucket{method="GET",path="/payments/{id}",le="0.1"} 1
+checkout_sdk_request_duration_seconds_bucket{method="GET",path="/payments/{id}",le="0.5"} 2
+checkout_sdk_request_duration_seconds_bucket{method="GET",path="/payments/{id}",le="+Inf"} 2
+checkout_sdk_request_duration_seconds_sum{method="GET",path="/payments/{id}"} 0.35
+checkout_sdk_request_duration_seconds_count{method="GET",path="/payments/{id}"} 2
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestPrometheus_ServeHTTP(t *testing.T) {
+	p := NewPrometheus()
+	p.ObserveRequest(configuration.RequestObservation{Method: http.MethodPost, PathTemplate: "/payments", StatusCode: http.StatusCreated})
+
+	recorder := httptest.NewRecorder()
+	p.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, prometheusContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `checkout_sdk_requests_total{method="POST",path="/payments",status="201"} 1`)
+}
+
+func TestPrometheus_EscapesLabelValues(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c\nd"`, quote("a\"b\\c\nd"))
+}
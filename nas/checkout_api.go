@@ -1,6 +1,8 @@
 package nas
 
 import (
+	"io"
+
 	"github.com/checkout/checkout-sdk-go/v2/accounts"
 	"github.com/checkout/checkout-sdk-go/v2/agenticcommerce"
 	"github.com/checkout/checkout-sdk-go/v2/identities/amlscreening"
@@ -84,12 +86,14 @@ type Api struct {
 	Sepa   *sepa.Client
 
 	OnboardingSimulator *onboardingsimulator.Client
+
+	credentials configuration.SdkCredentials
 }
 
 func CheckoutApi(configuration *configuration.Configuration) *Api {
 	apiClient := buildBaseClient(configuration)
 
-	api := Api{}
+	api := Api{credentials: configuration.Credentials}
 	api.Accounts = accounts.NewClient(configuration, apiClient, buildFilesClient(configuration))
 	api.Balances = balances.NewClient(configuration, buildBalancesClient(configuration))
 	api.Customers = customers.NewClient(configuration, apiClient)
@@ -132,21 +136,27 @@ func CheckoutApi(configuration *configuration.Configuration) *Api {
 	return &api
 }
 
-func buildBaseClient(configuration *configuration.Configuration) client.HttpClient {
-	if configuration.EnvironmentSubdomain != nil {
-		return client.NewApiClient(configuration, configuration.EnvironmentSubdomain.ApiUrl)
+// Close stops the background work of the credentials, such as the renewal of OAuth tokens from a timer. The Api can
+// still be used afterwards, its tokens being renewed by the calls that need them.
+func (a *Api) Close() error {
+	if closer, ok := a.credentials.(io.Closer); ok {
+		return closer.Close()
 	}
-	return client.NewApiClient(configuration, configuration.Environment.BaseUri())
+	return nil
+}
+
+func buildBaseClient(configuration *configuration.Configuration) client.HttpClient {
+	return client.NewApiClient(configuration, configuration.BaseUri())
 }
 
 func buildFilesClient(configuration *configuration.Configuration) client.HttpClient {
-	return client.NewApiClient(configuration, configuration.Environment.FilesUri())
+	return client.NewApiClient(configuration, configuration.FilesUri())
 }
 
 func buildBalancesClient(configuration *configuration.Configuration) client.HttpClient {
-	return client.NewApiClient(configuration, configuration.Environment.BalancesUri())
+	return client.NewApiClient(configuration, configuration.BalancesUri())
 }
 
 func buildTransfersClient(configuration *configuration.Configuration) client.HttpClient {
-	return client.NewApiClient(configuration, configuration.Environment.TransfersUri())
+	return client.NewApiClient(configuration, configuration.TransfersUri())
 }
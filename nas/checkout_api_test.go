@@ -0,0 +1,174 @@
+package nas
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/balances"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+func TestCustomEnvironment_SendsEveryServiceToItsUrl(t *testing.T) {
+	var mu sync.Mutex
+	var hosts []string
+	handler := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			hosts = append(hosts, name+" "+r.URL.Path)
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		})
+	}
+	api := httptest.NewServer(handler("api"))
+	defer api.Close()
+	balancesServer := httptest.NewServer(handler("balances"))
+	defer balancesServer.Close()
+
+	checkoutApi, err := (&CheckoutDefaultSdkBuilder{}).
+		WithEnvironment(configuration.Sandbox()).
+		WithEnvironmentSubdomain("abc1").
+		WithCustomEnvironment(&configuration.CustomEnvironment{ApiUrl: api.URL, BalancesUrl: balancesServer.URL, Sandbox: true}).
+		WithEnableTelemetry(false).
+		WithLogger(log.New(ioutil.Discard, "", 0)).
+		WithSecretKey("sk_sbox_m73dzbpy7cf3gfd46xr4yj5xo4e").
+		Build()
+	assert.Nil(t, err)
+
+	_, _ = checkoutApi.Balances.RetrieveEntityBalances("ent_123", balances.QueryFilter{})
+	_, _ = checkoutApi.Transfers.RetrieveTransfer("tra_123")
+
+	assert.Equal(t, []string{"balances /balances/ent_123", "api /transfers/tra_123"}, hosts)
+}
+
+func TestOAuthBuilder_SendsTokenAndApiRequestsThroughProxy(t *testing.T) {
+	var mu sync.Mutex
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		proxied = append(proxied, r.URL.String())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/connect/token" {
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+	proxyUrl, _ := url.Parse(proxy.URL)
+
+	checkoutApi, err := (&CheckoutOAuthSdkBuilder{}).
+		WithCustomEnvironment(&configuration.CustomEnvironment{ApiUrl: "http://api.checkout.test", Sandbox: true}).
+		WithProxy(proxyUrl).
+		WithMinTLSVersion(tls.VersionTLS12).
+		WithEnableTelemetry(false).
+		WithLogger(log.New(ioutil.Discard, "", 0)).
+		WithClientCredentials("client_id", "client_secret").
+		WithScopes([]string{configuration.Gateway}).
+		Build()
+	assert.Nil(t, err)
+
+	_, _ = checkoutApi.Transfers.RetrieveTransfer("tra_123")
+
+	assert.Equal(t, []string{"http://api.checkout.test/connect/token", "http://api.checkout.test/transfers/tra_123"}, proxied)
+}
+
+func TestOAuthBuilder_RequestsTokenOnBuildUnlessSharedThroughStore(t *testing.T) {
+	var mu sync.Mutex
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokenRequests++
+		mu.Unlock()
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	builder := func() *CheckoutOAuthSdkBuilder {
+		return (&CheckoutOAuthSdkBuilder{}).
+			WithCustomEnvironment(&configuration.CustomEnvironment{ApiUrl: server.URL, Sandbox: true}).
+			WithAuthorizationUri(server.URL).
+			WithEnableTelemetry(false).
+			WithLogger(log.New(ioutil.Discard, "", 0)).
+			WithClientCredentials("client_id", "client_secret").
+			WithScopes([]string{configuration.Gateway})
+	}
+
+	_, err := builder().Build()
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, tokenRequests)
+
+	_, err = builder().WithTokenStore(configuration.NewInMemoryTokenStore()).Build()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, tokenRequests)
+
+	_, err = builder().WithTokenStore(configuration.NewInMemoryTokenStore()).WithEagerToken(true).Build()
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, tokenRequests)
+
+	_, err = builder().WithEagerToken(false).Build()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, tokenRequests)
+}
+
+func TestOAuthBuilder_ClientMethodsReturnAuthorizationErrorOnRejectedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	for _, operationScopes := range []map[string][]string{nil, {"payments": {configuration.GatewayPaymentDetails}}} {
+		checkoutApi, err := (&CheckoutOAuthSdkBuilder{}).
+			WithCustomEnvironment(&configuration.CustomEnvironment{ApiUrl: server.URL, Sandbox: true}).
+			WithAuthorizationUri(server.URL).
+			WithEnableTelemetry(false).
+			WithLogger(log.New(ioutil.Discard, "", 0)).
+			WithClientCredentials("client_id", "client_secret").
+			WithScopes([]string{configuration.Gateway}).
+			WithOperationScopes(operationScopes).
+			WithEagerToken(false).
+			Build()
+		assert.Nil(t, err)
+
+		_, err = checkoutApi.Payments.GetPaymentDetails("pay_y3oqhf46pyzuxjbcn2giaqnb44")
+		authorizationError, ok := err.(errors.CheckoutAuthorizationError)
+		assert.True(t, ok, "%T", err)
+		assert.Equal(t, "invalid_client", authorizationError.Error())
+	}
+}
+
+func TestOAuthBuilder_RejectsOperationScopesNotGranted(t *testing.T) {
+	_, err := (&CheckoutOAuthSdkBuilder{}).
+		WithEnvironment(configuration.Sandbox()).
+		WithClientCredentials("client_id", "client_secret").
+		WithScopes([]string{configuration.Gateway, configuration.DisputesView}).
+		WithOperationScopes(configuration.DefaultOperationScopes).
+		WithEagerToken(false).
+		Build()
+
+	assert.IsType(t, errors.CheckoutArgumentError(""), err)
+	assert.Contains(t, err.Error(), configuration.DisputesAccept)
+	assert.NotContains(t, err.Error(), configuration.GatewayPaymentRefunds)
+}
+
+func TestBuilders_RejectInvalidTransportOptions(t *testing.T) {
+	_, err := (&CheckoutDefaultSdkBuilder{}).
+		WithEnvironment(configuration.Sandbox()).
+		WithMinTLSVersion(0x0200).
+		WithSecretKey("sk_sbox_m73dzbpy7cf3gfd46xr4yj5xo4e").
+		Build()
+
+	assert.EqualError(t, err, "Invalid minimum TLS version")
+}
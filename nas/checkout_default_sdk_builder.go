@@ -1,8 +1,12 @@
 package nas
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+	"net/url"
 
+	"github.com/checkout/checkout-sdk-go/v2/common"
 	"github.com/checkout/checkout-sdk-go/v2/configuration"
 )
 
@@ -20,6 +24,12 @@ func (b *CheckoutDefaultSdkBuilder) WithEnvironment(environment configuration.En
 	return b
 }
 
+func (b *CheckoutDefaultSdkBuilder) WithCustomEnvironment(environment *configuration.CustomEnvironment) *CheckoutDefaultSdkBuilder {
+	b.Environment = environment
+	b.EnvironmentSubdomain = nil
+	return b
+}
+
 func (b *CheckoutDefaultSdkBuilder) WithEnvironmentSubdomain(subdomain string) *CheckoutDefaultSdkBuilder {
 	b.EnvironmentSubdomain = configuration.NewEnvironmentSubdomain(b.Environment, subdomain)
 	return b
@@ -30,11 +40,76 @@ func (b *CheckoutDefaultSdkBuilder) WithHttpClient(client *http.Client) *Checkou
 	return b
 }
 
+func (b *CheckoutDefaultSdkBuilder) WithProxy(proxyUrl *url.URL) *CheckoutDefaultSdkBuilder {
+	b.TransportOptions().Proxy = proxyUrl
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithRootCAs(pool *x509.CertPool) *CheckoutDefaultSdkBuilder {
+	b.TransportOptions().RootCAs = pool
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithClientCertificate(certificate tls.Certificate) *CheckoutDefaultSdkBuilder {
+	b.TransportOptions().Certificates = append(b.TransportOptions().Certificates, certificate)
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithMinTLSVersion(version uint16) *CheckoutDefaultSdkBuilder {
+	b.TransportOptions().MinTLSVersion = version
+	return b
+}
+
 func (b *CheckoutDefaultSdkBuilder) WithLogger(logger configuration.StdLogger) *CheckoutDefaultSdkBuilder {
 	b.Logger = logger
 	return b
 }
 
+func (b *CheckoutDefaultSdkBuilder) WithRequestLogger(logger configuration.RequestLogger) *CheckoutDefaultSdkBuilder {
+	b.RequestLogger = logger
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithRedactor(redactor *common.Redactor) *CheckoutDefaultSdkBuilder {
+	b.Redactor = redactor
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithRateLimiter(limiter configuration.RateLimiter) *CheckoutDefaultSdkBuilder {
+	b.RateLimiter = limiter
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithCircuitBreaker(policy *configuration.CircuitBreakerPolicy) *CheckoutDefaultSdkBuilder {
+	b.CircuitBreaker = policy
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithMetrics(metrics configuration.Metrics) *CheckoutDefaultSdkBuilder {
+	b.Metrics = metrics
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithMiddleware(middlewares ...configuration.ClientMiddleware) *CheckoutDefaultSdkBuilder {
+	b.Middlewares = append(b.Middlewares, middlewares...)
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithRetryPolicy(policy *configuration.RetryPolicy) *CheckoutDefaultSdkBuilder {
+	b.RetryPolicy = policy
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithTracer(tracer configuration.Tracer) *CheckoutDefaultSdkBuilder {
+	b.Tracer = tracer
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithIdempotencyKeyGeneration(enabled bool) *CheckoutDefaultSdkBuilder {
+	b.GenerateIdempotencyKeys = enabled
+	return b
+}
+
 func (b *CheckoutDefaultSdkBuilder) WithPublicKey(publicKey string) *CheckoutDefaultSdkBuilder {
 	b.PublicKey = publicKey
 	return b
@@ -45,24 +120,41 @@ func (b *CheckoutDefaultSdkBuilder) WithSecretKey(secretKey string) *CheckoutDef
 	return b
 }
 
+func (b *CheckoutDefaultSdkBuilder) WithSecretSources(secretKey, publicKey configuration.SecretSource) *CheckoutDefaultSdkBuilder {
+	b.SecretKeySource = secretKey
+	b.PublicKeySource = publicKey
+	return b
+}
+
+func (b *CheckoutDefaultSdkBuilder) WithRotatingKeys(credentials *configuration.RotatingKeysSdkCredentials) *CheckoutDefaultSdkBuilder {
+	b.RotatingKeys = credentials
+	return b
+}
+
 func (b *CheckoutDefaultSdkBuilder) Build() (*Api, error) {
-	err := b.ValidateSecretKey(configuration.DefaultSecretKeyPattern)
-	if err != nil {
+	if err := b.ResolveHttpClient(); err != nil {
 		return nil, err
 	}
 
-	err = b.ValidatePublicKey(configuration.DefaultPublicKeyPattern)
+	sdkCredentials, err := b.Credentials(
+		configuration.Default,
+		configuration.DefaultSecretKeyPattern,
+		configuration.DefaultPublicKeyPattern,
+		func(secretKey, publicKey string) configuration.SdkCredentials {
+			return configuration.NewDefaultKeysSdkCredentials(secretKey, publicKey)
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	sdkCredentials := configuration.NewDefaultKeysSdkCredentials(b.SecretKey, b.PublicKey)
-
 	newConfiguration := configuration.NewConfiguration(sdkCredentials, b.EnableTelemetry, b.Environment, b.HttpClient, b.Logger)
 
 	if b.EnvironmentSubdomain != nil {
 		newConfiguration = configuration.NewConfigurationWithSubdomain(sdkCredentials, b.Environment, b.EnvironmentSubdomain, b.HttpClient, b.Logger)
 	}
 
+	b.ApplyOptions(newConfiguration)
+
 	return CheckoutApi(newConfiguration), nil
 }
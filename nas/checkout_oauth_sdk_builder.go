@@ -1,18 +1,29 @@
 package nas
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+	"net/url"
+	"time"
 
+	"github.com/checkout/checkout-sdk-go/v2/common"
 	"github.com/checkout/checkout-sdk-go/v2/configuration"
 	"github.com/checkout/checkout-sdk-go/v2/errors"
 )
 
 type CheckoutOAuthSdkBuilder struct {
 	configuration.SdkBuilder
-	ClientId         string
-	ClientSecret     string
-	AuthorizationUri string
-	Scopes           []string
+	ClientId           string
+	ClientSecret       string
+	ClientSecretSource configuration.SecretSource
+	AuthorizationUri   string
+	Scopes             []string
+	RefreshMargin      time.Duration
+	TokenStore         configuration.TokenStore
+	OperationScopes    map[string][]string
+
+	eagerToken *bool
 }
 
 func (b *CheckoutOAuthSdkBuilder) WithClientCredentials(id string, secret string) *CheckoutOAuthSdkBuilder {
@@ -21,6 +32,12 @@ func (b *CheckoutOAuthSdkBuilder) WithClientCredentials(id string, secret string
 	return b
 }
 
+func (b *CheckoutOAuthSdkBuilder) WithClientSecretSource(id string, source configuration.SecretSource) *CheckoutOAuthSdkBuilder {
+	b.ClientId = id
+	b.ClientSecretSource = source
+	return b
+}
+
 func (b *CheckoutOAuthSdkBuilder) WithAuthorizationUri(uri string) *CheckoutOAuthSdkBuilder {
 	b.AuthorizationUri = uri
 	return b
@@ -31,6 +48,26 @@ func (b *CheckoutOAuthSdkBuilder) WithScopes(scopes []string) *CheckoutOAuthSdkB
 	return b
 }
 
+func (b *CheckoutOAuthSdkBuilder) WithOperationScopes(operationScopes map[string][]string) *CheckoutOAuthSdkBuilder {
+	b.OperationScopes = operationScopes
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithTokenRefreshMargin(margin time.Duration) *CheckoutOAuthSdkBuilder {
+	b.RefreshMargin = margin
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithTokenStore(store configuration.TokenStore) *CheckoutOAuthSdkBuilder {
+	b.TokenStore = store
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithEagerToken(enabled bool) *CheckoutOAuthSdkBuilder {
+	b.eagerToken = &enabled
+	return b
+}
+
 func (b *CheckoutOAuthSdkBuilder) WithEnableTelemetry(telemetry bool) *CheckoutOAuthSdkBuilder {
 	b.EnableTelemetry = &telemetry
 	return b
@@ -41,6 +78,12 @@ func (b *CheckoutOAuthSdkBuilder) WithEnvironment(environment configuration.Envi
 	return b
 }
 
+func (b *CheckoutOAuthSdkBuilder) WithCustomEnvironment(environment *configuration.CustomEnvironment) *CheckoutOAuthSdkBuilder {
+	b.Environment = environment
+	b.EnvironmentSubdomain = nil
+	return b
+}
+
 func (b *CheckoutOAuthSdkBuilder) WithEnvironmentSubdomain(subdomain string) *CheckoutOAuthSdkBuilder {
 	b.EnvironmentSubdomain = configuration.NewEnvironmentSubdomain(b.Environment, subdomain)
 	return b
@@ -51,13 +94,82 @@ func (b *CheckoutOAuthSdkBuilder) WithHttpClient(client *http.Client) *CheckoutO
 	return b
 }
 
+func (b *CheckoutOAuthSdkBuilder) WithProxy(proxyUrl *url.URL) *CheckoutOAuthSdkBuilder {
+	b.TransportOptions().Proxy = proxyUrl
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithRootCAs(pool *x509.CertPool) *CheckoutOAuthSdkBuilder {
+	b.TransportOptions().RootCAs = pool
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithClientCertificate(certificate tls.Certificate) *CheckoutOAuthSdkBuilder {
+	b.TransportOptions().Certificates = append(b.TransportOptions().Certificates, certificate)
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithMinTLSVersion(version uint16) *CheckoutOAuthSdkBuilder {
+	b.TransportOptions().MinTLSVersion = version
+	return b
+}
+
 func (b *CheckoutOAuthSdkBuilder) WithLogger(logger configuration.StdLogger) *CheckoutOAuthSdkBuilder {
 	b.Logger = logger
 	return b
 }
 
+func (b *CheckoutOAuthSdkBuilder) WithRequestLogger(logger configuration.RequestLogger) *CheckoutOAuthSdkBuilder {
+	b.RequestLogger = logger
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithRedactor(redactor *common.Redactor) *CheckoutOAuthSdkBuilder {
+	b.Redactor = redactor
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithRateLimiter(limiter configuration.RateLimiter) *CheckoutOAuthSdkBuilder {
+	b.RateLimiter = limiter
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithCircuitBreaker(policy *configuration.CircuitBreakerPolicy) *CheckoutOAuthSdkBuilder {
+	b.CircuitBreaker = policy
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithMetrics(metrics configuration.Metrics) *CheckoutOAuthSdkBuilder {
+	b.Metrics = metrics
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithMiddleware(middlewares ...configuration.ClientMiddleware) *CheckoutOAuthSdkBuilder {
+	b.Middlewares = append(b.Middlewares, middlewares...)
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithRetryPolicy(policy *configuration.RetryPolicy) *CheckoutOAuthSdkBuilder {
+	b.RetryPolicy = policy
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithTracer(tracer configuration.Tracer) *CheckoutOAuthSdkBuilder {
+	b.Tracer = tracer
+	return b
+}
+
+func (b *CheckoutOAuthSdkBuilder) WithIdempotencyKeyGeneration(enabled bool) *CheckoutOAuthSdkBuilder {
+	b.GenerateIdempotencyKeys = enabled
+	return b
+}
+
 func (b *CheckoutOAuthSdkBuilder) Build() (*Api, error) {
-	if b.ClientId == "" || b.ClientSecret == "" {
+	if err := b.ResolveHttpClient(); err != nil {
+		return nil, err
+	}
+
+	if b.ClientId == "" || (b.ClientSecret == "" && b.ClientSecretSource == nil) {
 		return nil, errors.CheckoutArgumentError("Invalid OAuth 'client_id' or 'client_secret'")
 	}
 
@@ -69,12 +181,7 @@ func (b *CheckoutOAuthSdkBuilder) Build() (*Api, error) {
 		}
 	}
 
-	sdkCredentials, err := configuration.NewOAuthSdkCredentials(
-		b.ClientId,
-		b.ClientSecret,
-		b.AuthorizationUri,
-		b.Scopes,
-		b.Logger)
+	sdkCredentials, err := b.credentials()
 	if err != nil {
 		return nil, err
 	}
@@ -85,5 +192,60 @@ func (b *CheckoutOAuthSdkBuilder) Build() (*Api, error) {
 		newConfiguration = configuration.NewConfigurationWithSubdomain(sdkCredentials, b.Environment, b.EnvironmentSubdomain, b.HttpClient, b.Logger)
 	}
 
+	b.ApplyOptions(newConfiguration)
+
 	return CheckoutApi(newConfiguration), nil
 }
+
+func (b *CheckoutOAuthSdkBuilder) credentials() (configuration.SdkCredentials, error) {
+	logger := b.Logger
+	if logger == nil {
+		logger = configuration.DefaultLogger()
+	}
+
+	if b.OperationScopes != nil {
+		scopedCredentials := &configuration.ScopedOAuthSdkCredentials{
+			HttpClient:         b.HttpClient,
+			ClientId:           b.ClientId,
+			ClientSecret:       b.ClientSecret,
+			ClientSecretSource: b.ClientSecretSource,
+			AuthorizationUri:   b.AuthorizationUri,
+			GrantedScopes:      b.Scopes,
+			OperationScopes:    b.OperationScopes,
+			Log:                logger,
+			RefreshMargin:      b.RefreshMargin,
+			TokenStore:         b.TokenStore,
+		}
+		if err := scopedCredentials.Validate(); err != nil {
+			return nil, err
+		}
+		return scopedCredentials, nil
+	}
+
+	sdkCredentials := &configuration.OAuthSdkCredentials{
+		HttpClient:         b.HttpClient,
+		ClientId:           b.ClientId,
+		ClientSecret:       b.ClientSecret,
+		ClientSecretSource: b.ClientSecretSource,
+		AuthorizationUri:   b.AuthorizationUri,
+		Scopes:             b.Scopes,
+		Log:                logger,
+		RefreshMargin:      b.RefreshMargin,
+		TokenStore:         b.TokenStore,
+	}
+	if b.requestsTokenOnBuild() {
+		if err := sdkCredentials.GetAccessToken(); err != nil {
+			return nil, err
+		}
+	}
+	return sdkCredentials, nil
+}
+
+// requestsTokenOnBuild reports whether Build requests a token, failing early on invalid credentials. Unless
+// WithEagerToken says otherwise, it does not when a token store is shared, the token being requested by the first call.
+func (b *CheckoutOAuthSdkBuilder) requestsTokenOnBuild() bool {
+	if b.eagerToken != nil {
+		return *b.eagerToken
+	}
+	return b.TokenStore == nil
+}
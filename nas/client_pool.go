@@ -0,0 +1,415 @@
+package nas
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/errors"
+)
+
+const (
+	// DefaultUnhealthyThreshold is the number of consecutive failed calls after which a tenant is reported unhealthy
+	DefaultUnhealthyThreshold = 5
+	// DefaultTenantBuildTimeout bounds the resolution and the build of a tenant
+	DefaultTenantBuildTimeout = 30 * time.Second
+)
+
+type (
+	// TenantResolver returns the builder of a tenant, for example a merchant identified by its entity id or its
+	// subdomain. It is called the first time the tenant is used and after the tenant was evicted
+	TenantResolver func(ctx context.Context, tenant string) (DefaultSdkBuilder, error)
+
+	// ClientPool builds an Api per tenant the first time it is requested, and keeps it until it is evicted. Every
+	// tenant shares the HTTP client of the pool, and so its connections, unless its builder sets its own.
+	// The fields must not be changed once the pool is in use
+	ClientPool struct {
+		// HttpClient is shared by the tenants. A client with its own copy of the default transport is used when it
+		// is nil
+		HttpClient *http.Client
+		// MaxTenants bounds the number of tenants kept, the least recently used being evicted first. There is no
+		// bound when it is zero
+		MaxTenants int
+		// IdleTimeout evicts the tenants unused for longer than it. Tenants are kept until evicted when it is zero
+		IdleTimeout time.Duration
+		// UnhealthyThreshold is the number of consecutive failed calls after which a tenant is reported unhealthy.
+		// DefaultUnhealthyThreshold is used when it is zero
+		UnhealthyThreshold int
+		// BuildTimeout bounds the resolution and the build of a tenant, which are shared by every caller waiting for
+		// the tenant and so do not stop when the caller that started them does. DefaultTenantBuildTimeout is used when
+		// it is zero
+		BuildTimeout time.Duration
+
+		resolver TenantResolver
+		once     sync.Once
+		mu       sync.Mutex
+		tenants  map[string]*pooledTenant
+		stats    map[string]*tenantMetrics
+	}
+
+	// TenantStats describes the calls made for a tenant since it was first requested
+	TenantStats struct {
+		Tenant   string
+		Requests int64
+		// Failures counts the calls that failed for a reason other than the request itself: network errors,
+		// timeouts, server errors, open circuits and rejected credentials
+		Failures            int64
+		ConsecutiveFailures int
+		// Errors counts the failed calls by class, client errors included
+		Errors map[configuration.ErrorClass]int64
+		// AverageDuration is the mean duration of the calls, retries included
+		AverageDuration time.Duration
+		LastUsed        time.Time
+		// LastError is the last error building the tenant or classifying a failed call
+		LastError string
+		// Healthy is false after UnhealthyThreshold consecutive failures, or when the tenant could not be built
+		Healthy bool
+	}
+
+	// pooledTenant is a build of a tenant. It records its calls in the statistics the tenant had when the build
+	// started, so that a build evicted while in flight cannot bring back the statistics of the tenant
+	pooledTenant struct {
+		api      *Api
+		err      error
+		ready    chan struct{}
+		lastUsed time.Time
+		metrics  *tenantMetrics
+	}
+)
+
+func NewClientPool(resolver TenantResolver) *ClientPool {
+	return &ClientPool{resolver: resolver}
+}
+
+// Get returns the Api of the tenant, building it with the resolver when it is not in the pool. Concurrent calls for
+// a tenant being built wait for a single build. A failed build is not kept, so the next call tries again.
+// The resolver is called with the values of ctx but without its cancellation, bounded by BuildTimeout: cancelling ctx
+// only stops waiting for the tenant.
+func (p *ClientPool) Get(ctx context.Context, tenant string) (*Api, error) {
+	p.once.Do(p.init)
+
+	p.mu.Lock()
+	p.evictIdle(time.Now())
+	entry, ok := p.tenants[tenant]
+	if !ok {
+		entry = &pooledTenant{ready: make(chan struct{}), metrics: p.tenantMetrics(tenant)}
+		p.tenants[tenant] = entry
+		go p.build(configuration.WithoutCancel(ctx), tenant, entry)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	p.mu.Lock()
+	entry.lastUsed = time.Now()
+	p.mu.Unlock()
+	return entry.api, nil
+}
+
+// Evict removes the tenant and its statistics from the pool, for example after its credentials changed. The next
+// call to Get builds it again. A build in flight still completes for the callers waiting for it, but the calls of
+// the Api it returns are no longer counted.
+func (p *ClientPool) Evict(tenant string) {
+	p.once.Do(p.init)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.remove(tenant)
+}
+
+// Tenants returns the tenants in the pool, sorted.
+func (p *ClientPool) Tenants() []string {
+	p.once.Do(p.init)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tenants := make([]string, 0, len(p.tenants))
+	for tenant, entry := range p.tenants {
+		if entry.api != nil {
+			tenants = append(tenants, tenant)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+// Stats returns the statistics of a tenant, and false when the tenant was never requested or was evicted.
+func (p *ClientPool) Stats(tenant string) (TenantStats, bool) {
+	p.once.Do(p.init)
+
+	p.mu.Lock()
+	metrics, ok := p.stats[tenant]
+	p.mu.Unlock()
+	if !ok {
+		return TenantStats{}, false
+	}
+	return metrics.snapshot(tenant, p.unhealthyThreshold()), true
+}
+
+// AllStats returns the statistics of every tenant known to the pool, sorted by tenant.
+func (p *ClientPool) AllStats() []TenantStats {
+	p.once.Do(p.init)
+
+	p.mu.Lock()
+	tenants := make([]string, 0, len(p.stats))
+	for tenant := range p.stats {
+		tenants = append(tenants, tenant)
+	}
+	p.mu.Unlock()
+	sort.Strings(tenants)
+
+	stats := make([]TenantStats, 0, len(tenants))
+	for _, tenant := range tenants {
+		if s, ok := p.Stats(tenant); ok {
+			stats = append(stats, s)
+		}
+	}
+	return stats
+}
+
+func (p *ClientPool) init() {
+	p.tenants = make(map[string]*pooledTenant)
+	p.stats = make(map[string]*tenantMetrics)
+	if p.HttpClient == nil {
+		p.HttpClient = &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+	}
+}
+
+func (p *ClientPool) build(ctx context.Context, tenant string, entry *pooledTenant) {
+	ctx, cancel := context.WithTimeout(ctx, p.buildTimeout())
+	defer cancel()
+	api, err := p.newApi(ctx, tenant, entry.metrics)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	metrics := entry.metrics
+	if err != nil {
+		metrics.buildFailed(err)
+		entry.err = err
+		if p.tenants[tenant] == entry {
+			delete(p.tenants, tenant)
+		}
+	} else {
+		metrics.built()
+		entry.api = api
+		entry.lastUsed = time.Now()
+		if p.tenants[tenant] == entry {
+			p.evictLeastRecentlyUsed(tenant)
+		} else {
+			// the tenant was evicted while it was built, so nothing closes the Api later
+			_ = api.Close()
+		}
+	}
+	close(entry.ready)
+}
+
+func (p *ClientPool) newApi(ctx context.Context, tenant string, metrics *tenantMetrics) (*Api, error) {
+	if p.resolver == nil {
+		return nil, errors.CheckoutArgumentError("The client pool has no tenant resolver")
+	}
+
+	builder, err := p.resolver(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if builder == nil {
+		return nil, errors.CheckoutArgumentError("No builder for tenant " + tenant)
+	}
+
+	if configurable, ok := builder.(interface {
+		Settings() *configuration.SdkBuilder
+	}); ok {
+		settings := configurable.Settings()
+		if settings.HttpClient == nil {
+			settings.HttpClient = p.HttpClient
+		}
+		metrics.setNext(settings.Metrics)
+		settings.Metrics = metrics
+	}
+
+	return builder.Build()
+}
+
+// tenantMetrics returns the statistics of a tenant, creating them when needed. The caller holds the lock.
+func (p *ClientPool) tenantMetrics(tenant string) *tenantMetrics {
+	metrics, ok := p.stats[tenant]
+	if !ok {
+		metrics = &tenantMetrics{errors: make(map[configuration.ErrorClass]int64)}
+		p.stats[tenant] = metrics
+	}
+	return metrics
+}
+
+// evictIdle removes the tenants unused for longer than IdleTimeout. The caller holds the lock.
+func (p *ClientPool) evictIdle(now time.Time) {
+	if p.IdleTimeout <= 0 {
+		return
+	}
+	for tenant, entry := range p.tenants {
+		if entry.api != nil && now.Sub(entry.lastUsed) > p.IdleTimeout {
+			p.remove(tenant)
+		}
+	}
+}
+
+// evictLeastRecentlyUsed removes tenants, other than the one just built, until the pool holds at most MaxTenants.
+// The caller holds the lock.
+func (p *ClientPool) evictLeastRecentlyUsed(built string) {
+	if p.MaxTenants <= 0 {
+		return
+	}
+	for len(p.tenants) > p.MaxTenants {
+		var oldest string
+		var oldestUse time.Time
+		for tenant, entry := range p.tenants {
+			if tenant == built || entry.api == nil {
+				continue
+			}
+			if oldest == "" || entry.lastUsed.Before(oldestUse) {
+				oldest, oldestUse = tenant, entry.lastUsed
+			}
+		}
+		if oldest == "" {
+			return
+		}
+		p.remove(oldest)
+	}
+}
+
+// remove also closes the Api of the tenant, which its callers can keep using, so that its credentials stop renewing
+// their token in the background.
+func (p *ClientPool) remove(tenant string) {
+	if entry, ok := p.tenants[tenant]; ok && entry.api != nil {
+		_ = entry.api.Close()
+	}
+	delete(p.tenants, tenant)
+	delete(p.stats, tenant)
+}
+
+func (p *ClientPool) buildTimeout() time.Duration {
+	if p.BuildTimeout > 0 {
+		return p.BuildTimeout
+	}
+	return DefaultTenantBuildTimeout
+}
+
+func (p *ClientPool) unhealthyThreshold() int {
+	if p.UnhealthyThreshold > 0 {
+		return p.UnhealthyThreshold
+	}
+	return DefaultUnhealthyThreshold
+}
+
+// tenantMetrics records the calls of a tenant and forwards them to the metrics configured by its builder, if any.
+type tenantMetrics struct {
+	mu                  sync.Mutex
+	next                configuration.Metrics
+	requests            int64
+	failures            int64
+	consecutiveFailures int
+	errors              map[configuration.ErrorClass]int64
+	totalDuration       time.Duration
+	lastUsed            time.Time
+	lastError           string
+	buildError          bool
+}
+
+func (m *tenantMetrics) ObserveRequest(observation configuration.RequestObservation) {
+	m.mu.Lock()
+	m.requests++
+	m.totalDuration += observation.Duration
+	m.lastUsed = time.Now()
+	if observation.ErrorClass != configuration.NoError {
+		m.errors[observation.ErrorClass]++
+	}
+	if isTenantFailure(observation) {
+		m.failures++
+		m.consecutiveFailures++
+		m.lastError = string(observation.ErrorClass) + " on " + observation.Method + " " + observation.PathTemplate
+	} else {
+		m.consecutiveFailures = 0
+	}
+	next := m.next
+	m.mu.Unlock()
+
+	if next != nil {
+		next.ObserveRequest(observation)
+	}
+}
+
+func (m *tenantMetrics) ObserveRetry(method string, pathTemplate string) {
+	m.mu.Lock()
+	next := m.next
+	m.mu.Unlock()
+
+	if next != nil {
+		next.ObserveRetry(method, pathTemplate)
+	}
+}
+
+func (m *tenantMetrics) setNext(next configuration.Metrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.next = next
+}
+
+func (m *tenantMetrics) built() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.buildError = false
+}
+
+func (m *tenantMetrics) buildFailed(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.buildError = true
+	m.lastError = err.Error()
+}
+
+func (m *tenantMetrics) snapshot(tenant string, unhealthyThreshold int) TenantStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := TenantStats{
+		Tenant:              tenant,
+		Requests:            m.requests,
+		Failures:            m.failures,
+		ConsecutiveFailures: m.consecutiveFailures,
+		Errors:              make(map[configuration.ErrorClass]int64, len(m.errors)),
+		LastUsed:            m.lastUsed,
+		LastError:           m.lastError,
+		Healthy:             !m.buildError && m.consecutiveFailures < unhealthyThreshold,
+	}
+	for class, count := range m.errors {
+		stats.Errors[class] = count
+	}
+	if m.requests > 0 {
+		stats.AverageDuration = m.totalDuration / time.Duration(m.requests)
+	}
+	return stats
+}
+
+// isTenantFailure reports whether a call failed for a reason that points at the tenant or the API rather than at
+// the request, such as rejected credentials.
+func isTenantFailure(observation configuration.RequestObservation) bool {
+	switch observation.ErrorClass {
+	case configuration.ServerError, configuration.TimeoutError, configuration.NetworkError, configuration.CircuitOpen:
+		return true
+	case configuration.ClientError:
+		return observation.StatusCode == http.StatusUnauthorized || observation.StatusCode == http.StatusForbidden
+	default:
+		return false
+	}
+}
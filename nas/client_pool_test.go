@@ -0,0 +1,310 @@
+package nas
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+var tenantKeys = map[string]string{
+	"merchant_a": "sk_sbox_m73dzbpy7cf3gfd46xr4yj5xo4e",
+	"merchant_b": "sk_sbox_2zb5qvyk5pjaedrvxfa4ydlzoyq",
+	"merchant_c": "sk_sbox_3zb5qvyk5pjaedrvxfa4ydlzoyq",
+}
+
+func poolServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+tenantKeys["merchant_b"] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"id":"pay_123"}`)
+	}))
+}
+
+func newTestPool(server *httptest.Server, builds *int32) *ClientPool {
+	environment := configuration.NewEnvironment(server.URL, server.URL, server.URL, server.URL, server.URL, true)
+	return NewClientPool(func(ctx context.Context, tenant string) (DefaultSdkBuilder, error) {
+		atomic.AddInt32(builds, 1)
+		key, ok := tenantKeys[tenant]
+		if !ok {
+			return nil, fmt.Errorf("unknown tenant %s", tenant)
+		}
+		return (&CheckoutDefaultSdkBuilder{}).
+			WithEnvironment(environment).
+			WithEnableTelemetry(false).
+			WithLogger(log.New(ioutil.Discard, "", 0)).
+			WithSecretKey(key), nil
+	})
+}
+
+func TestClientPool_BuildsEachTenantOnce(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	pool := newTestPool(server, &builds)
+
+	var wg sync.WaitGroup
+	apis := make([]*Api, 10)
+	for i := range apis {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			apis[i], _ = pool.Get(context.Background(), "merchant_a")
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), builds)
+	for _, api := range apis {
+		assert.Same(t, apis[0], api)
+	}
+	assert.Equal(t, []string{"merchant_a"}, pool.Tenants())
+}
+
+func TestClientPool_SharesHttpClient(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	pool := newTestPool(server, &builds)
+
+	var requests int32
+	pool.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	for _, tenant := range []string{"merchant_a", "merchant_c"} {
+		api, err := pool.Get(context.Background(), tenant)
+		assert.Nil(t, err)
+		_, err = api.Payments.GetPaymentDetails("pay_y3oqhf46pyzuxjbcn2giaqnb44")
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, int32(2), requests)
+}
+
+func TestClientPool_EvictsLeastRecentlyUsedAndIdleTenants(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	pool := newTestPool(server, &builds)
+	pool.MaxTenants = 2
+
+	for _, tenant := range []string{"merchant_a", "merchant_b", "merchant_a", "merchant_c"} {
+		_, err := pool.Get(context.Background(), tenant)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, []string{"merchant_a", "merchant_c"}, pool.Tenants())
+
+	pool.Evict("merchant_a")
+	assert.Equal(t, []string{"merchant_c"}, pool.Tenants())
+
+	pool.IdleTimeout = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	_, _ = pool.Get(context.Background(), "merchant_a")
+	assert.Equal(t, []string{"merchant_a"}, pool.Tenants())
+	assert.Equal(t, int32(4), builds)
+}
+
+func TestClientPool_FailedBuildIsRetried(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	pool := newTestPool(server, &builds)
+
+	for i := 0; i < 2; i++ {
+		_, err := pool.Get(context.Background(), "merchant_unknown")
+		assert.EqualError(t, err, "unknown tenant merchant_unknown")
+	}
+	assert.Equal(t, int32(2), builds)
+	assert.Empty(t, pool.Tenants())
+
+	stats, ok := pool.Stats("merchant_unknown")
+	assert.True(t, ok)
+	assert.False(t, stats.Healthy)
+	assert.Equal(t, "unknown tenant merchant_unknown", stats.LastError)
+}
+
+func TestClientPool_TenantHealthAndStats(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	pool := newTestPool(server, &builds)
+	pool.UnhealthyThreshold = 2
+
+	for _, tenant := range []string{"merchant_a", "merchant_b"} {
+		api, err := pool.Get(context.Background(), tenant)
+		assert.Nil(t, err)
+		for i := 0; i < 2; i++ {
+			_, _ = api.Payments.GetPaymentDetails("pay_y3oqhf46pyzuxjbcn2giaqnb44")
+		}
+	}
+
+	stats := pool.AllStats()
+	assert.Equal(t, 2, len(stats))
+
+	assert.Equal(t, "merchant_a", stats[0].Tenant)
+	assert.Equal(t, int64(2), stats[0].Requests)
+	assert.Equal(t, int64(0), stats[0].Failures)
+	assert.True(t, stats[0].Healthy)
+
+	assert.Equal(t, "merchant_b", stats[1].Tenant)
+	assert.Equal(t, int64(2), stats[1].Failures)
+	assert.Equal(t, int64(2), stats[1].Errors[configuration.ClientError])
+	assert.Equal(t, "client_error on GET /payments/{id}", stats[1].LastError)
+	assert.False(t, stats[1].Healthy)
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type tenantContextKey struct{}
+
+func TestClientPool_BuildOutlivesTheCallerThatStartedIt(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	release := make(chan struct{})
+	resolverCtx := make(chan context.Context, 1)
+	resolve := newTestPool(server, &builds).resolver
+	pool := NewClientPool(func(ctx context.Context, tenant string) (DefaultSdkBuilder, error) {
+		resolverCtx <- ctx
+		<-release
+		return resolve(ctx, tenant)
+	})
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), tenantContextKey{}, "trace"))
+	first := make(chan error, 1)
+	go func() {
+		_, err := pool.Get(ctx, "merchant_a")
+		first <- err
+	}()
+	ctxOfResolver := <-resolverCtx
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+
+	close(release)
+	api, err := pool.Get(context.Background(), "merchant_a")
+	assert.Nil(t, err)
+	assert.NotNil(t, api)
+	assert.Equal(t, int32(1), builds)
+	assert.Equal(t, "trace", ctxOfResolver.Value(tenantContextKey{}))
+}
+
+func TestClientPool_BuildTimeout(t *testing.T) {
+	pool := NewClientPool(func(ctx context.Context, tenant string) (DefaultSdkBuilder, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	pool.BuildTimeout = 10 * time.Millisecond
+
+	_, err := pool.Get(context.Background(), "merchant_a")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, pool.Tenants())
+}
+
+func TestClientPool_EvictDuringBuildResetsStats(t *testing.T) {
+	server := poolServer()
+	defer server.Close()
+
+	var builds int32
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+	resolve := newTestPool(server, &builds).resolver
+	pool := NewClientPool(func(ctx context.Context, tenant string) (DefaultSdkBuilder, error) {
+		started <- struct{}{}
+		<-release
+		return resolve(ctx, tenant)
+	})
+
+	evicted := make(chan *Api, 1)
+	go func() {
+		api, err := pool.Get(context.Background(), "merchant_a")
+		assert.Nil(t, err)
+		evicted <- api
+	}()
+	<-started
+	pool.Evict("merchant_a")
+	close(release)
+
+	api := <-evicted
+	_, err := api.Payments.GetPaymentDetails("pay_y3oqhf46pyzuxjbcn2giaqnb44")
+	assert.Nil(t, err)
+	_, ok := pool.Stats("merchant_a")
+	assert.False(t, ok)
+	assert.Empty(t, pool.Tenants())
+
+	rebuilt, err := pool.Get(context.Background(), "merchant_a")
+	assert.Nil(t, err)
+	assert.NotSame(t, api, rebuilt)
+	_, err = rebuilt.Payments.GetPaymentDetails("pay_y3oqhf46pyzuxjbcn2giaqnb44")
+	assert.Nil(t, err)
+	_, err = api.Payments.GetPaymentDetails("pay_y3oqhf46pyzuxjbcn2giaqnb44")
+	assert.Nil(t, err)
+
+	stats, ok := pool.Stats("merchant_a")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), stats.Requests)
+	assert.True(t, stats.Healthy)
+	assert.Equal(t, int32(2), builds)
+}
+
+type closingCredentials struct {
+	configuration.SdkCredentials
+	closed int32
+}
+
+func (c *closingCredentials) Close() error {
+	atomic.AddInt32(&c.closed, 1)
+	return nil
+}
+
+type apiBuilder func() (*Api, error)
+
+func (b apiBuilder) Build() (*Api, error) {
+	return b()
+}
+
+func TestClientPool_ClosesEvictedTenants(t *testing.T) {
+	credentials := map[string]*closingCredentials{}
+	pool := NewClientPool(func(ctx context.Context, tenant string) (DefaultSdkBuilder, error) {
+		return apiBuilder(func() (*Api, error) {
+			tenantCredentials := &closingCredentials{SdkCredentials: configuration.NewDefaultKeysSdkCredentials(tenantKeys[tenant], "")}
+			credentials[tenant] = tenantCredentials
+			return CheckoutApi(configuration.NewConfiguration(tenantCredentials, nil, configuration.Sandbox(), &http.Client{}, nil)), nil
+		}), nil
+	})
+	pool.MaxTenants = 1
+
+	_, err := pool.Get(context.Background(), "merchant_a")
+	assert.Nil(t, err)
+	_, err = pool.Get(context.Background(), "merchant_c")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&credentials["merchant_a"].closed))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&credentials["merchant_c"].closed))
+
+	pool.Evict("merchant_c")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&credentials["merchant_c"].closed))
+}
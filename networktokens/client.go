@@ -29,8 +29,9 @@ func (c *Client) ProvisionNetworkToken(request ProvisionNetworkTokenRequest) (*N
 
 // ProvisionNetworkTokenWithContext is like ProvisionNetworkToken but accepts a context for
 // cancellation and deadline propagation.
-func (c *Client) ProvisionNetworkTokenWithContext(ctx context.Context, request ProvisionNetworkTokenRequest) (*NetworkTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) ProvisionNetworkTokenWithContext(ctx context.Context, request ProvisionNetworkTokenRequest, opts ...client.RequestOption) (*NetworkTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +52,9 @@ func (c *Client) GetNetworkToken(networkTokenId string) (*NetworkTokenResponse,
 
 // GetNetworkTokenWithContext is like GetNetworkToken but accepts a context for cancellation
 // and deadline propagation.
-func (c *Client) GetNetworkTokenWithContext(ctx context.Context, networkTokenId string) (*NetworkTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) GetNetworkTokenWithContext(ctx context.Context, networkTokenId string, opts ...client.RequestOption) (*NetworkTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +75,9 @@ func (c *Client) RequestCryptogram(networkTokenId string, request RequestCryptog
 
 // RequestCryptogramWithContext is like RequestCryptogram but accepts a context for cancellation
 // and deadline propagation.
-func (c *Client) RequestCryptogramWithContext(ctx context.Context, networkTokenId string, request RequestCryptogramRequest) (*RequestCryptogramResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) RequestCryptogramWithContext(ctx context.Context, networkTokenId string, request RequestCryptogramRequest, opts ...client.RequestOption) (*RequestCryptogramResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -95,8 +98,9 @@ func (c *Client) DeleteNetworkToken(networkTokenId string, request DeleteNetwork
 
 // DeleteNetworkTokenWithContext is like DeleteNetworkToken but accepts a context for
 // cancellation and deadline propagation.
-func (c *Client) DeleteNetworkTokenWithContext(ctx context.Context, networkTokenId string, request DeleteNetworkTokenRequest) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) DeleteNetworkTokenWithContext(ctx context.Context, networkTokenId string, request DeleteNetworkTokenRequest, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
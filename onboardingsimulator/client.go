@@ -34,8 +34,10 @@ func (c *Client) SetRequirementsDueWithContext(
 	ctx context.Context,
 	entityId string,
 	request SimulatorSetRequirementsDueRequest,
+	opts ...client.RequestOption,
 ) (*SimulatorSetRequirementsDueResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +65,10 @@ func (c *Client) RunScenario(entityId, scenarioId string) (*SimulatorRunScenario
 func (c *Client) RunScenarioWithContext(
 	ctx context.Context,
 	entityId, scenarioId string,
+	opts ...client.RequestOption,
 ) (*SimulatorRunScenarioResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +100,10 @@ func (c *Client) SetEntityStatusWithContext(
 	ctx context.Context,
 	entityId string,
 	request SimulatorSetStatusRequest,
+	opts ...client.RequestOption,
 ) (*SimulatorSetStatusResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -124,8 +130,10 @@ func (c *Client) ListAvailableRequirements() (*SimulatorAvailableRequirementsRes
 
 func (c *Client) ListAvailableRequirementsWithContext(
 	ctx context.Context,
+	opts ...client.RequestOption,
 ) (*SimulatorAvailableRequirementsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -150,8 +158,10 @@ func (c *Client) ListScenarios() (*SimulatorScenariosResponse, error) {
 
 func (c *Client) ListScenariosWithContext(
 	ctx context.Context,
+	opts ...client.RequestOption,
 ) (*SimulatorScenariosResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,115 @@
+// Package opentelemetry traces the calls made by the Checkout SDK with OpenTelemetry.
+//
+//	api, err := checkout.Builder().
+//		StaticKeys().
+//		WithSecretKey("secret_key").
+//		WithTracer(opentelemetry.NewTracer()).
+//		Build()
+package opentelemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+const instrumentationName = "github.com/checkout/checkout-sdk-go/opentelemetry"
+
+type (
+	// Tracer implements configuration.Tracer on top of an OpenTelemetry TracerProvider
+	Tracer struct {
+		tracer     trace.Tracer
+		propagator propagation.TextMapPropagator
+	}
+
+	Option func(*options)
+
+	options struct {
+		provider   trace.TracerProvider
+		propagator propagation.TextMapPropagator
+	}
+
+	span struct {
+		span trace.Span
+	}
+)
+
+// WithTracerProvider sets the provider used to create spans. The global provider is used by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.provider = provider
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context on outgoing requests.
+// W3C trace context is used by default
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+func NewTracer(opts ...Option) *Tracer {
+	o := options{
+		provider:   otel.GetTracerProvider(),
+		propagator: propagation.TraceContext{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Tracer{
+		tracer:     o.provider.Tracer(instrumentationName, trace.WithInstrumentationVersion(client.SDK_VERSION)),
+		propagator: o.propagator,
+	}
+}
+
+func (t *Tracer) Start(ctx context.Context, operation string) (context.Context, configuration.Span) {
+	ctx, s := t.tracer.Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{span: s}
+}
+
+func (t *Tracer) Inject(ctx context.Context, header http.Header) {
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+func (s *span) SetAttribute(key string, value interface{}) {
+	s.span.SetAttributes(toAttribute(key, value))
+}
+
+func (s *span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *span) End() {
+	s.span.End()
+}
+
+func toAttribute(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	case []string:
+		return attribute.StringSlice(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,98 @@
+package opentelemetry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTracer() (*Tracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return NewTracer(WithTracerProvider(provider)), recorder
+}
+
+func TestTracer_RecordsClientSpanWithAttributes(t *testing.T) {
+	tracer, recorder := newTestTracer()
+
+	_, span := tracer.Start(context.Background(), "payments.RequestPayment")
+	span.SetAttribute("url.template", "/payments")
+	span.SetAttribute("http.response.status_code", 201)
+	span.SetAttribute("checkout.error_codes", []string{"amount_required"})
+	span.End()
+
+	ended := recorder.Ended()
+	assert.Len(t, ended, 1)
+	assert.Equal(t, "payments.RequestPayment", ended[0].Name())
+	assert.Equal(t, trace.SpanKindClient, ended[0].SpanKind())
+	assert.Contains(t, ended[0].Attributes(), attribute.String("url.template", "/payments"))
+	assert.Contains(t, ended[0].Attributes(), attribute.Int("http.response.status_code", 201))
+	assert.Contains(t, ended[0].Attributes(), attribute.StringSlice("checkout.error_codes", []string{"amount_required"}))
+}
+
+func TestTracer_RecordErrorSetsStatus(t *testing.T) {
+	tracer, recorder := newTestTracer()
+
+	_, span := tracer.Start(context.Background(), "payments.RefundPayment")
+	span.RecordError(errors.New("422 Unprocessable Entity"))
+	span.End()
+
+	ended := recorder.Ended()
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Len(t, ended[0].Events(), 1)
+}
+
+func TestTracer_InjectsW3CTraceContext(t *testing.T) {
+	tracer, _ := newTestTracer()
+
+	ctx, span := tracer.Start(context.Background(), "payments.GetPaymentDetails")
+	defer span.End()
+
+	header := http.Header{}
+	tracer.Inject(ctx, header)
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	assert.Equal(t, "00-"+spanContext.TraceID().String()+"-"+spanContext.SpanID().String()+"-01", header.Get("traceparent"))
+}
+
+func TestTracer_SdkSpansCarryNoPathPersonalData(t *testing.T) {
+	tracer, recorder := newTestTracer()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"cus_y3oqhf46pyzuxjbcn2giaqnb44"}`))
+	}))
+	defer server.Close()
+
+	apiClient := &client.ApiClient{
+		HttpClient: *server.Client(),
+		BaseUri:    server.URL,
+		Log:        log.New(io.Discard, "", 0),
+		Tracer:     tracer,
+	}
+	authorization := &configuration.SdkAuthorization{PlatformType: configuration.Default, Credential: "sk_sbox_secret"}
+	var response common.IdResponse
+	assert.Nil(t, apiClient.Get("/customers/jane@x.com", authorization, &response))
+
+	ended := recorder.Ended()
+	assert.Len(t, ended, 1)
+	assert.Contains(t, ended[0].Attributes(), attribute.String("url.template", "/customers/{id}"))
+	assert.NotContains(t, ended[0].Name(), "jane")
+	for _, attr := range ended[0].Attributes() {
+		assert.False(t, strings.Contains(attr.Value.Emit(), "jane"), string(attr.Key))
+	}
+}
@@ -24,8 +24,9 @@ func (c *Client) GetAvailablePaymentMethods(query GetPaymentMethodsQuery) (*GetP
 	return c.GetAvailablePaymentMethodsWithContext(context.Background(), query)
 }
 
-func (c *Client) GetAvailablePaymentMethodsWithContext(ctx context.Context, query GetPaymentMethodsQuery) (*GetPaymentMethodsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetAvailablePaymentMethodsWithContext(ctx context.Context, query GetPaymentMethodsQuery, opts ...client.RequestOption) (*GetPaymentMethodsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
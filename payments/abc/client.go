@@ -24,8 +24,9 @@ func (c *Client) RequestPayment(request PaymentRequest, idempotencyKey *string)
 	return c.RequestPaymentWithContext(context.Background(), request, idempotencyKey)
 }
 
-func (c *Client) RequestPaymentWithContext(ctx context.Context, request PaymentRequest, idempotencyKey *string) (*PaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RequestPaymentWithContext(ctx context.Context, request PaymentRequest, idempotencyKey *string, opts ...client.RequestOption) (*PaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +51,9 @@ func (c *Client) RequestPaymentList(request payments.QueryRequest) (*GetPaymentL
 	return c.RequestPaymentListWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestPaymentListWithContext(ctx context.Context, request payments.QueryRequest) (*GetPaymentListResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RequestPaymentListWithContext(ctx context.Context, request payments.QueryRequest, opts ...client.RequestOption) (*GetPaymentListResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -74,8 +76,9 @@ func (c *Client) RequestPayout(request PayoutRequest, idempotencyKey *string) (*
 	return c.RequestPayoutWithContext(context.Background(), request, idempotencyKey)
 }
 
-func (c *Client) RequestPayoutWithContext(ctx context.Context, request PayoutRequest, idempotencyKey *string) (*PaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RequestPayoutWithContext(ctx context.Context, request PayoutRequest, idempotencyKey *string, opts ...client.RequestOption) (*PaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +103,9 @@ func (c *Client) GetPaymentDetails(paymentId string) (*GetPaymentResponse, error
 	return c.GetPaymentDetailsWithContext(context.Background(), paymentId)
 }
 
-func (c *Client) GetPaymentDetailsWithContext(ctx context.Context, paymentId string) (*GetPaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetPaymentDetailsWithContext(ctx context.Context, paymentId string, opts ...client.RequestOption) (*GetPaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +123,9 @@ func (c *Client) GetPaymentActions(paymentId string) (*GetPaymentActionsResponse
 	return c.GetPaymentActionsWithContext(context.Background(), paymentId)
 }
 
-func (c *Client) GetPaymentActionsWithContext(ctx context.Context, paymentId string) (*GetPaymentActionsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetPaymentActionsWithContext(ctx context.Context, paymentId string, opts ...client.RequestOption) (*GetPaymentActionsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -152,8 +157,10 @@ func (c *Client) CapturePaymentWithContext(
 	paymentId string,
 	captureRequest CaptureRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.CaptureResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -185,8 +192,10 @@ func (c *Client) CapturePaymentWithoutRequestWithContext(
 	ctx context.Context,
 	paymentId string,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.CaptureResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -220,8 +229,10 @@ func (c *Client) RefundPaymentWithContext(
 	paymentId string,
 	refundRequest *payments.RefundRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.RefundResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -255,8 +266,10 @@ func (c *Client) VoidPaymentWithContext(
 	paymentId string,
 	voidRequest *payments.VoidRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.VoidResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
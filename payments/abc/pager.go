@@ -0,0 +1,36 @@
+//go:build go1.23
+
+package abc
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/payments"
+)
+
+const defaultPaymentListLimit = 10
+
+// PaymentsPager pages through the payments matching the request, starting at request.Skip.
+func (c *Client) PaymentsPager(request payments.QueryRequest, opts ...client.RequestOption) *common.Pager[GetPaymentResponse] {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultPaymentListLimit
+	}
+
+	return common.NewOffsetPager(request.Skip, limit, func(ctx context.Context, skip, limit int) ([]GetPaymentResponse, int, error) {
+		pageRequest := request
+		pageRequest.Skip, pageRequest.Limit = skip, limit
+		response, err := c.RequestPaymentListWithContext(ctx, pageRequest, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Data, response.TotalCount, nil
+	})
+}
+
+func (c *Client) AllPayments(ctx context.Context, request payments.QueryRequest, opts ...client.RequestOption) iter.Seq2[GetPaymentResponse, error] {
+	return c.PaymentsPager(request, opts...).All(ctx)
+}
@@ -24,8 +24,9 @@ func (c *Client) UploadPaymentProcessingCertificate(request UploadCertificateReq
 	return c.UploadPaymentProcessingCertificateWithContext(context.Background(), request)
 }
 
-func (c *Client) UploadPaymentProcessingCertificateWithContext(ctx context.Context, request UploadCertificateRequest) (*UploadCertificateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) UploadPaymentProcessingCertificateWithContext(ctx context.Context, request UploadCertificateRequest, opts ...client.RequestOption) (*UploadCertificateResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) EnrollDomain(request EnrollDomainRequest) (*common.MetadataResp
 	return c.EnrollDomainWithContext(context.Background(), request)
 }
 
-func (c *Client) EnrollDomainWithContext(ctx context.Context, request EnrollDomainRequest) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) EnrollDomainWithContext(ctx context.Context, request EnrollDomainRequest, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) GenerateCertificateSigningRequest(request GenerateCertificateSi
 	return c.GenerateCertificateSigningRequestWithContext(context.Background(), request)
 }
 
-func (c *Client) GenerateCertificateSigningRequestWithContext(ctx context.Context, request GenerateCertificateSigningRequest) (*GenerateCertificateSigningRequestResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) GenerateCertificateSigningRequestWithContext(ctx context.Context, request GenerateCertificateSigningRequest, opts ...client.RequestOption) (*GenerateCertificateSigningRequestResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
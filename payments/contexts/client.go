@@ -24,8 +24,9 @@ func (c *Client) RequestPaymentContexts(request PaymentContextsRequest) (*Paymen
 	return c.RequestPaymentContextsWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestPaymentContextsWithContext(ctx context.Context, request PaymentContextsRequest) (*PaymentContextsRequestResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RequestPaymentContextsWithContext(ctx context.Context, request PaymentContextsRequest, opts ...client.RequestOption) (*PaymentContextsRequestResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) GetPaymentContextDetails(paymentContextId string) (*PaymentCont
 	return c.GetPaymentContextDetailsWithContext(context.Background(), paymentContextId)
 }
 
-func (c *Client) GetPaymentContextDetailsWithContext(ctx context.Context, paymentContextId string) (*PaymentContextDetailsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetPaymentContextDetailsWithContext(ctx context.Context, paymentContextId string, opts ...client.RequestOption) (*PaymentContextDetailsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
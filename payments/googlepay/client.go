@@ -24,8 +24,9 @@ func (c *Client) CreateEnrollment(request CreateEnrollmentRequest) (*CreateEnrol
 	return c.CreateEnrollmentWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateEnrollmentWithContext(ctx context.Context, request CreateEnrollmentRequest) (*CreateEnrollmentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) CreateEnrollmentWithContext(ctx context.Context, request CreateEnrollmentRequest, opts ...client.RequestOption) (*CreateEnrollmentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) RegisterDomain(entityId string, request RegisterDomainRequest)
 	return c.RegisterDomainWithContext(context.Background(), entityId, request)
 }
 
-func (c *Client) RegisterDomainWithContext(ctx context.Context, entityId string, request RegisterDomainRequest) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) RegisterDomainWithContext(ctx context.Context, entityId string, request RegisterDomainRequest, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) GetRegisteredDomains(entityId string) (*DomainListResponse, err
 	return c.GetRegisteredDomainsWithContext(context.Background(), entityId)
 }
 
-func (c *Client) GetRegisteredDomainsWithContext(ctx context.Context, entityId string) (*DomainListResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) GetRegisteredDomainsWithContext(ctx context.Context, entityId string, opts ...client.RequestOption) (*DomainListResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +84,9 @@ func (c *Client) GetEnrollmentState(entityId string) (*EnrollmentStateResponse,
 	return c.GetEnrollmentStateWithContext(context.Background(), entityId)
 }
 
-func (c *Client) GetEnrollmentStateWithContext(ctx context.Context, entityId string) (*EnrollmentStateResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) GetEnrollmentStateWithContext(ctx context.Context, entityId string, opts ...client.RequestOption) (*EnrollmentStateResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
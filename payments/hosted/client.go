@@ -23,8 +23,9 @@ func (c *Client) CreateHostedPaymentsPageSession(request PaymentHostedRequest) (
 	return c.CreateHostedPaymentsPageSessionWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateHostedPaymentsPageSessionWithContext(ctx context.Context, request PaymentHostedRequest) (*PaymentHostedResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CreateHostedPaymentsPageSessionWithContext(ctx context.Context, request PaymentHostedRequest, opts ...client.RequestOption) (*PaymentHostedResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,9 @@ func (c *Client) GetHostedPaymentsPageDetails(hostedPaymentId string) (*PaymentH
 	return c.GetHostedPaymentsPageDetailsWithContext(context.Background(), hostedPaymentId)
 }
 
-func (c *Client) GetHostedPaymentsPageDetailsWithContext(ctx context.Context, hostedPaymentId string) (*PaymentHostedDetails, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetHostedPaymentsPageDetailsWithContext(ctx context.Context, hostedPaymentId string, opts ...client.RequestOption) (*PaymentHostedDetails, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -23,8 +23,9 @@ func (c *Client) CreatePaymentLink(request PaymentLinkRequest) (*PaymentLinkResp
 	return c.CreatePaymentLinkWithContext(context.Background(), request)
 }
 
-func (c *Client) CreatePaymentLinkWithContext(ctx context.Context, request PaymentLinkRequest) (*PaymentLinkResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CreatePaymentLinkWithContext(ctx context.Context, request PaymentLinkRequest, opts ...client.RequestOption) (*PaymentLinkResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,9 @@ func (c *Client) GetPaymentLink(paymentLinkId string) (*PaymentLinkDetails, erro
 	return c.GetPaymentLinkWithContext(context.Background(), paymentLinkId)
 }
 
-func (c *Client) GetPaymentLinkWithContext(ctx context.Context, paymentLinkId string) (*PaymentLinkDetails, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetPaymentLinkWithContext(ctx context.Context, paymentLinkId string, opts ...client.RequestOption) (*PaymentLinkDetails, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
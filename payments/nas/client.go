@@ -25,8 +25,9 @@ func (c *Client) RequestPayment(request PaymentRequest, idempotencyKey *string)
 	return c.RequestPaymentWithContext(context.Background(), request, idempotencyKey)
 }
 
-func (c *Client) RequestPaymentWithContext(ctx context.Context, request PaymentRequest, idempotencyKey *string) (*PaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RequestPaymentWithContext(ctx context.Context, request PaymentRequest, idempotencyKey *string, opts ...client.RequestOption) (*PaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +52,9 @@ func (c *Client) RequestPaymentList(request payments.QueryRequest) (*GetPaymentL
 	return c.RequestPaymentListWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestPaymentListWithContext(ctx context.Context, request payments.QueryRequest) (*GetPaymentListResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RequestPaymentListWithContext(ctx context.Context, request payments.QueryRequest, opts ...client.RequestOption) (*GetPaymentListResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +77,9 @@ func (c *Client) RequestPayout(request PayoutRequest, idempotencyKey *string) (*
 	return c.RequestPayoutWithContext(context.Background(), request, idempotencyKey)
 }
 
-func (c *Client) RequestPayoutWithContext(ctx context.Context, request PayoutRequest, idempotencyKey *string) (*PayoutResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RequestPayoutWithContext(ctx context.Context, request PayoutRequest, idempotencyKey *string, opts ...client.RequestOption) (*PayoutResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +104,9 @@ func (c *Client) GetPaymentDetails(paymentId string) (*GetPaymentResponse, error
 	return c.GetPaymentDetailsWithContext(context.Background(), paymentId)
 }
 
-func (c *Client) GetPaymentDetailsWithContext(ctx context.Context, paymentId string) (*GetPaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetPaymentDetailsWithContext(ctx context.Context, paymentId string, opts ...client.RequestOption) (*GetPaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -120,8 +124,9 @@ func (c *Client) GetPaymentActions(paymentId string) (*GetPaymentActionsResponse
 	return c.GetPaymentActionsWithContext(context.Background(), paymentId)
 }
 
-func (c *Client) GetPaymentActionsWithContext(ctx context.Context, paymentId string) (*GetPaymentActionsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetPaymentActionsWithContext(ctx context.Context, paymentId string, opts ...client.RequestOption) (*GetPaymentActionsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +158,10 @@ func (c *Client) IncrementAuthorizationWithContext(
 	paymentId string,
 	incrementAuthorizationRequest IncrementAuthorizationRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*IncrementAuthorizationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -188,8 +195,10 @@ func (c *Client) CapturePaymentWithContext(
 	paymentId string,
 	captureRequest CaptureRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.CaptureResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -221,8 +230,10 @@ func (c *Client) CapturePaymentWithoutRequestWithContext(
 	ctx context.Context,
 	paymentId string,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.CaptureResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +267,10 @@ func (c *Client) RefundPaymentWithContext(
 	paymentId string,
 	refundRequest *payments.RefundRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.RefundResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -291,8 +304,10 @@ func (c *Client) VoidPaymentWithContext(
 	paymentId string,
 	voidRequest *payments.VoidRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.VoidResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -326,8 +341,10 @@ func (c *Client) CancelAScheduledRetryWithContext(
 	paymentId string,
 	request *payments.CancellationRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.CancellationResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -361,8 +378,10 @@ func (c *Client) ReversePaymentWithContext(
 	paymentId string,
 	request *payments.PaymentReversalRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*payments.PaymentReversalResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -387,8 +406,9 @@ func (c *Client) SearchPayments(request SearchPaymentsRequest) (*SearchPaymentsR
 	return c.SearchPaymentsWithContext(context.Background(), request)
 }
 
-func (c *Client) SearchPaymentsWithContext(ctx context.Context, request SearchPaymentsRequest) (*SearchPaymentsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) SearchPaymentsWithContext(ctx context.Context, request SearchPaymentsRequest, opts ...client.RequestOption) (*SearchPaymentsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -1788,3 +1788,25 @@ func assertPaymentReversalResponse(t *testing.T, response *payments.PaymentRever
 	assert.NotEmpty(t, response.Links)
 	assert.NotEmpty(t, response.Links["payment"])
 }
+
+func TestRefundPaymentWithoutGrantedScope(t *testing.T) {
+	credentials := &configuration.ScopedOAuthSdkCredentials{
+		ClientId:        "client_id",
+		ClientSecret:    "client_secret",
+		GrantedScopes:   []string{configuration.GatewayPayment},
+		OperationScopes: configuration.DefaultOperationScopes,
+	}
+	apiClient := new(mocks.ApiClientMock)
+	enableTelemetry := true
+	config := configuration.NewConfiguration(credentials, &enableTelemetry, new(mocks.EnvironmentMock), &http.Client{}, nil)
+	client := NewClient(config, apiClient)
+
+	response, err := client.RefundPayment("pay_1234", &payments.RefundRequest{Amount: amount}, nil)
+
+	assert.Nil(t, response)
+	assert.Equal(t, errors.CheckoutScopeError{
+		Operation: "payments.RefundPayment",
+		Scopes:    []string{configuration.GatewayPaymentRefunds},
+	}, err)
+	apiClient.AssertNotCalled(t, "PostWithContext")
+}
@@ -24,8 +24,9 @@ func (c *Client) RequestPaymentSessions(request PaymentSessionsRequest) (*Paymen
 	return c.RequestPaymentSessionsWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestPaymentSessionsWithContext(ctx context.Context, request PaymentSessionsRequest) (*PaymentSessionsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RequestPaymentSessionsWithContext(ctx context.Context, request PaymentSessionsRequest, opts ...client.RequestOption) (*PaymentSessionsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +44,9 @@ func (c *Client) RequestPaymentSessionsWithPayment(request PaymentSessionsWithPa
 	return c.RequestPaymentSessionsWithPaymentWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestPaymentSessionsWithPaymentWithContext(ctx context.Context, request PaymentSessionsWithPaymentRequest) (*PaymentSessionPaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) RequestPaymentSessionsWithPaymentWithContext(ctx context.Context, request PaymentSessionsWithPaymentRequest, opts ...client.RequestOption) (*PaymentSessionPaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +64,9 @@ func (c *Client) SubmitPaymentSession(sessionId string, request SubmitPaymentSes
 	return c.SubmitPaymentSessionWithContext(context.Background(), sessionId, request)
 }
 
-func (c *Client) SubmitPaymentSessionWithContext(ctx context.Context, sessionId string, request SubmitPaymentSessionRequest) (*PaymentSessionPaymentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) SubmitPaymentSessionWithContext(ctx context.Context, sessionId string, request SubmitPaymentSessionRequest, opts ...client.RequestOption) (*PaymentSessionPaymentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -33,8 +33,9 @@ func (c *Client) CreatePaymentSetup(request PaymentSetupRequest) (*PaymentSetupR
 	return c.CreatePaymentSetupWithContext(context.Background(), request)
 }
 
-func (c *Client) CreatePaymentSetupWithContext(ctx context.Context, request PaymentSetupRequest) (*PaymentSetupResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) CreatePaymentSetupWithContext(ctx context.Context, request PaymentSetupRequest, opts ...client.RequestOption) (*PaymentSetupResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +61,9 @@ func (c *Client) UpdatePaymentSetup(setupId string, request PaymentSetupRequest)
 	return c.UpdatePaymentSetupWithContext(context.Background(), setupId, request)
 }
 
-func (c *Client) UpdatePaymentSetupWithContext(ctx context.Context, setupId string, request PaymentSetupRequest) (*PaymentSetupResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) UpdatePaymentSetupWithContext(ctx context.Context, setupId string, request PaymentSetupRequest, opts ...client.RequestOption) (*PaymentSetupResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -83,8 +85,9 @@ func (c *Client) GetPaymentSetup(setupId string) (*PaymentSetupResponse, error)
 	return c.GetPaymentSetupWithContext(context.Background(), setupId)
 }
 
-func (c *Client) GetPaymentSetupWithContext(ctx context.Context, setupId string) (*PaymentSetupResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetPaymentSetupWithContext(ctx context.Context, setupId string, opts ...client.RequestOption) (*PaymentSetupResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +111,9 @@ func (c *Client) ConfirmPaymentSetup(setupId string, paymentMethodOptionId strin
 	return c.ConfirmPaymentSetupWithContext(context.Background(), setupId, paymentMethodOptionId)
 }
 
-func (c *Client) ConfirmPaymentSetupWithContext(ctx context.Context, setupId string, paymentMethodOptionId string) (*PaymentSetupConfirmResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) ConfirmPaymentSetupWithContext(ctx context.Context, setupId string, paymentMethodOptionId string, opts ...client.RequestOption) (*PaymentSetupConfirmResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
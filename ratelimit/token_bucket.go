@@ -0,0 +1,228 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxBuckets bounds the number of buckets kept in memory, the least recently used bucket being dropped to make
+	// room for a new one.
+	MaxBuckets = 1024
+
+	decreaseFactor = 0.5
+	minRateFactor  = 0.1
+	increaseFactor = 0.05
+)
+
+type (
+	Limit struct {
+		// Rate is the number of calls allowed per second
+		Rate float64
+		// Burst is the number of calls that can be sent at once
+		Burst int
+	}
+
+	// Group applies its own limit to the paths starting with Prefix, for example "/payments" or "/disputes"
+	Group struct {
+		Prefix string
+		Limit
+	}
+
+	Config struct {
+		// Default applies to the paths that do not match any group. A zero rate leaves them unlimited
+		Default Limit
+		Groups  []Group
+		// Credentials replaces the configuration for some credentials, keyed by their
+		// configuration.CredentialFingerprint. The Credentials of the replacing configurations are ignored
+		Credentials map[string]Config
+	}
+
+	// TokenBucket limits the calls per credential and per endpoint group. When the API answers 429, the bucket
+	// pauses until the Retry-After delay has elapsed and halves its rate, then recovers gradually on success.
+	TokenBucket struct {
+		config  Config
+		now     func() time.Time
+		mutex   sync.Mutex
+		buckets map[bucketKey]*bucket
+	}
+
+	bucketKey struct {
+		credential string
+		group      string
+	}
+
+	bucket struct {
+		key          bucketKey
+		limit        Limit
+		rate         float64
+		tokens       float64
+		last         time.Time
+		used         time.Time
+		blockedUntil time.Time
+	}
+)
+
+func NewTokenBucket(config Config) *TokenBucket {
+	return &TokenBucket{
+		config:  config,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*bucket),
+	}
+}
+
+func (l *TokenBucket) Wait(ctx context.Context, credential string, path string) error {
+	l.mutex.Lock()
+	b := l.bucket(credential, path)
+	if b == nil {
+		l.mutex.Unlock()
+		return ctx.Err()
+	}
+	delay := b.reserve(l.now())
+	l.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mutex.Lock()
+		// the bucket may have been dropped in the meantime, the token then belonging to none of them
+		if l.buckets[b.key] == b {
+			b.tokens++
+		}
+		l.mutex.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (l *TokenBucket) Observe(credential string, path string, statusCode int, retryAfter time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b := l.bucket(credential, path)
+	if b == nil {
+		return
+	}
+
+	now := l.now()
+	b.refill(now)
+	if statusCode == http.StatusTooManyRequests {
+		b.rate = maxFloat(b.rate*decreaseFactor, b.limit.Rate*minRateFactor)
+		if until := now.Add(retryAfter); until.After(b.blockedUntil) {
+			b.blockedUntil = until
+		}
+		return
+	}
+
+	if statusCode < http.StatusBadRequest && b.rate < b.limit.Rate {
+		b.rate = minFloat(b.rate+b.limit.Rate*increaseFactor, b.limit.Rate)
+	}
+}
+
+// bucket returns the bucket of the credential and endpoint group of the path, or nil when the path is unlimited.
+// The caller must hold the mutex.
+func (l *TokenBucket) bucket(credential string, path string) *bucket {
+	group, limit := l.group(credential, path)
+	if limit.Rate <= 0 {
+		return nil
+	}
+
+	key := bucketKey{credential: credential, group: group}
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= MaxBuckets {
+			l.dropLeastRecentlyUsed()
+		}
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		b = &bucket{
+			key:    key,
+			limit:  Limit{Rate: limit.Rate, Burst: burst},
+			rate:   limit.Rate,
+			tokens: float64(burst),
+			last:   l.now(),
+		}
+		l.buckets[key] = b
+	}
+	b.used = l.now()
+	return b
+}
+
+// dropLeastRecentlyUsed removes the bucket used the longest time ago. The caller must hold the mutex.
+func (l *TokenBucket) dropLeastRecentlyUsed() {
+	var oldest *bucket
+	for _, b := range l.buckets {
+		if oldest == nil || b.used.Before(oldest.used) {
+			oldest = b
+		}
+	}
+	if oldest != nil {
+		delete(l.buckets, oldest.key)
+	}
+}
+
+func (l *TokenBucket) group(credential string, path string) (string, Limit) {
+	config := l.config
+	if override, ok := l.config.Credentials[credential]; ok {
+		config = override
+	}
+
+	match := -1
+	for i, group := range config.Groups {
+		if strings.HasPrefix(path, group.Prefix) && (match < 0 || len(group.Prefix) > len(config.Groups[match].Prefix)) {
+			match = i
+		}
+	}
+	if match < 0 {
+		return "", config.Default
+	}
+	return config.Groups[match].Prefix, config.Groups[match].Limit
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = minFloat(b.tokens+elapsed*b.rate, float64(b.limit.Burst))
+		b.last = now
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before using it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	if blocked := b.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+	return delay
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,176 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestBucket(config Config) (*TokenBucket, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	limiter := NewTokenBucket(config)
+	limiter.now = clock.Now
+	return limiter, clock
+}
+
+func TestTokenBucket_AllowsBurst(t *testing.T) {
+	limiter, _ := newTestBucket(Config{Default: Limit{Rate: 1, Burst: 3}})
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, "key", "/payments"))
+}
+
+func TestTokenBucket_RefillsOverTime(t *testing.T) {
+	limiter, clock := newTestBucket(Config{Default: Limit{Rate: 10, Burst: 1}})
+
+	assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	clock.now = clock.now.Add(100 * time.Millisecond)
+	assert.Equal(t, time.Duration(0), limiter.buckets[bucketKey{credential: "key"}].reserve(clock.now))
+}
+
+func TestTokenBucket_CancelledWaitReturnsToken(t *testing.T) {
+	limiter, clock := newTestBucket(Config{Default: Limit{Rate: 1, Burst: 1}})
+
+	assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, limiter.Wait(ctx, "key", "/payments"))
+
+	clock.now = clock.now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), limiter.buckets[bucketKey{credential: "key"}].reserve(clock.now))
+}
+
+func TestTokenBucket_CancelledWaitDoesNotRefundDroppedBucket(t *testing.T) {
+	limiter, clock := newTestBucket(Config{Default: Limit{Rate: 1, Burst: 1}})
+
+	assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	dropped := limiter.buckets[bucketKey{credential: "key"}]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	waited := make(chan error)
+	go func() {
+		waited <- limiter.Wait(ctx, "key", "/payments")
+	}()
+	assert.Eventually(t, func() bool {
+		limiter.mutex.Lock()
+		defer limiter.mutex.Unlock()
+		return dropped.tokens < 0
+	}, time.Second, time.Millisecond)
+
+	for i := 0; i < MaxBuckets; i++ {
+		limiter.mutex.Lock()
+		clock.now = clock.now.Add(time.Millisecond)
+		limiter.mutex.Unlock()
+		assert.Nil(t, limiter.Wait(context.Background(), strconv.Itoa(i), "/payments"))
+	}
+	assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	current := limiter.buckets[bucketKey{credential: "key"}]
+	assert.NotSame(t, dropped, current)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-waited)
+	assert.Equal(t, float64(-1), dropped.tokens)
+	assert.Equal(t, float64(0), current.tokens)
+}
+
+func TestTokenBucket_DropsLeastRecentlyUsedBucket(t *testing.T) {
+	limiter, clock := newTestBucket(Config{Default: Limit{Rate: 1, Burst: 100}})
+
+	for i := 0; i < MaxBuckets; i++ {
+		clock.now = clock.now.Add(time.Millisecond)
+		assert.Nil(t, limiter.Wait(context.Background(), strconv.Itoa(i), "/payments"))
+	}
+	clock.now = clock.now.Add(time.Millisecond)
+	limiter.Observe("0", "/payments", http.StatusTooManyRequests, 0)
+
+	clock.now = clock.now.Add(time.Millisecond)
+	assert.Nil(t, limiter.Wait(context.Background(), "new", "/payments"))
+
+	assert.Len(t, limiter.buckets, MaxBuckets)
+	assert.NotNil(t, limiter.buckets[bucketKey{credential: "0"}], "the bucket adapted to a 429 is kept")
+	assert.Nil(t, limiter.buckets[bucketKey{credential: "1"}])
+	assert.Equal(t, 0.5, limiter.buckets[bucketKey{credential: "0"}].rate)
+}
+
+func TestTokenBucket_CredentialOverride(t *testing.T) {
+	limiter, _ := newTestBucket(Config{
+		Default: Limit{Rate: 1, Burst: 1},
+		Credentials: map[string]Config{
+			"platform": {
+				Default: Limit{Rate: 100, Burst: 50},
+				Groups:  []Group{{Prefix: "/reports"}},
+			},
+		},
+	})
+
+	for i := 0; i < 50; i++ {
+		assert.Nil(t, limiter.Wait(context.Background(), "platform", "/payments"))
+		assert.Nil(t, limiter.Wait(context.Background(), "platform", "/reports"))
+	}
+	assert.Equal(t, float64(100), limiter.buckets[bucketKey{credential: "platform"}].limit.Rate)
+	assert.Nil(t, limiter.Wait(context.Background(), "merchant", "/payments"))
+	assert.Equal(t, float64(1), limiter.buckets[bucketKey{credential: "merchant"}].limit.Rate)
+	assert.Len(t, limiter.buckets, 2)
+}
+
+func TestTokenBucket_SeparatesCredentialsAndGroups(t *testing.T) {
+	limiter, _ := newTestBucket(Config{
+		Default: Limit{Rate: 1, Burst: 1},
+		Groups: []Group{
+			{Prefix: "/payments", Limit: Limit{Rate: 1, Burst: 1}},
+			{Prefix: "/disputes", Limit: Limit{Rate: 1, Burst: 1}},
+		},
+	})
+
+	assert.Nil(t, limiter.Wait(context.Background(), "a", "/payments/pay_123"))
+	assert.Nil(t, limiter.Wait(context.Background(), "b", "/payments/pay_123"))
+	assert.Nil(t, limiter.Wait(context.Background(), "a", "/disputes"))
+	assert.Nil(t, limiter.Wait(context.Background(), "a", "/customers"))
+	assert.Len(t, limiter.buckets, 4)
+}
+
+func TestTokenBucket_UnlimitedWithoutRate(t *testing.T) {
+	limiter, _ := newTestBucket(Config{Groups: []Group{{Prefix: "/reports", Limit: Limit{Rate: 1}}}})
+
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	}
+	assert.Empty(t, limiter.buckets)
+}
+
+func TestTokenBucket_AdaptsToTooManyRequests(t *testing.T) {
+	limiter, clock := newTestBucket(Config{Default: Limit{Rate: 10, Burst: 10}})
+
+	assert.Nil(t, limiter.Wait(context.Background(), "key", "/payments"))
+	limiter.Observe("key", "/payments", http.StatusTooManyRequests, 2*time.Second)
+
+	b := limiter.buckets[bucketKey{credential: "key"}]
+	assert.Equal(t, float64(5), b.rate)
+	assert.Equal(t, 2*time.Second, b.reserve(clock.now))
+
+	clock.now = clock.now.Add(3 * time.Second)
+	limiter.Observe("key", "/payments", http.StatusOK, 0)
+	assert.Equal(t, 5.5, b.rate)
+
+	for i := 0; i < 20; i++ {
+		limiter.Observe("key", "/payments", http.StatusOK, 0)
+	}
+	assert.Equal(t, float64(10), b.rate)
+}
@@ -2,6 +2,8 @@ package reconciliation
 
 import (
 	"context"
+	"io"
+
 	"github.com/checkout/checkout-sdk-go/v2/client"
 	"github.com/checkout/checkout-sdk-go/v2/common"
 	"github.com/checkout/checkout-sdk-go/v2/configuration"
@@ -23,8 +25,9 @@ func (c *Client) QueryPaymentsReport(query PaymentReportsQuery) (*PaymentReports
 	return c.QueryPaymentsReportWithContext(context.Background(), query)
 }
 
-func (c *Client) QueryPaymentsReportWithContext(ctx context.Context, query PaymentReportsQuery) (*PaymentReportsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) QueryPaymentsReportWithContext(ctx context.Context, query PaymentReportsQuery, opts ...client.RequestOption) (*PaymentReportsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +50,9 @@ func (c *Client) GetSinglePaymentReport(paymentId string) (*PaymentReportsRespon
 	return c.GetSinglePaymentReportWithContext(context.Background(), paymentId)
 }
 
-func (c *Client) GetSinglePaymentReportWithContext(ctx context.Context, paymentId string) (*PaymentReportsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) GetSinglePaymentReportWithContext(ctx context.Context, paymentId string, opts ...client.RequestOption) (*PaymentReportsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +70,9 @@ func (c *Client) QueryStatementsReport(query common.DateRangeQuery) (*StatementR
 	return c.QueryStatementsReportWithContext(context.Background(), query)
 }
 
-func (c *Client) QueryStatementsReportWithContext(ctx context.Context, query common.DateRangeQuery) (*StatementReportsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) QueryStatementsReportWithContext(ctx context.Context, query common.DateRangeQuery, opts ...client.RequestOption) (*StatementReportsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -90,8 +95,9 @@ func (c *Client) RetrieveCVSPaymentsReport(query common.DateRangeQuery) (*common
 	return c.RetrieveCVSPaymentsReportWithContext(context.Background(), query)
 }
 
-func (c *Client) RetrieveCVSPaymentsReportWithContext(ctx context.Context, query common.DateRangeQuery) (*common.ContentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveCVSPaymentsReportWithContext(ctx context.Context, query common.DateRangeQuery, opts ...client.RequestOption) (*common.ContentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -114,8 +120,9 @@ func (c *Client) RetrieveCVSSingleStatementReport(statementId string) (*common.C
 	return c.RetrieveCVSSingleStatementReportWithContext(context.Background(), statementId)
 }
 
-func (c *Client) RetrieveCVSSingleStatementReportWithContext(ctx context.Context, statementId string) (*common.ContentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveCVSSingleStatementReportWithContext(ctx context.Context, statementId string, opts ...client.RequestOption) (*common.ContentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -133,8 +140,9 @@ func (c *Client) RetrieveCVSStatementsReport(query common.DateRangeQuery) (*comm
 	return c.RetrieveCVSStatementsReportWithContext(context.Background(), query)
 }
 
-func (c *Client) RetrieveCVSStatementsReportWithContext(ctx context.Context, query common.DateRangeQuery) (*common.ContentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveCVSStatementsReportWithContext(ctx context.Context, query common.DateRangeQuery, opts ...client.RequestOption) (*common.ContentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -152,3 +160,124 @@ func (c *Client) RetrieveCVSStatementsReportWithContext(ctx context.Context, que
 
 	return &response, nil
 }
+
+func (c *Client) StreamCVSPaymentsReport(query common.DateRangeQuery) (*common.StreamResponse, error) {
+	return c.StreamCVSPaymentsReportWithContext(context.Background(), query)
+}
+
+func (c *Client) StreamCVSPaymentsReportWithContext(ctx context.Context, query common.DateRangeQuery, opts ...client.RequestOption) (*common.StreamResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := common.BuildQueryPath(common.BuildPath(reporting, payments, download), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var response common.StreamResponse
+	err = c.apiClient.GetWithContext(ctx, url, auth, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) DownloadCVSPaymentsReport(query common.DateRangeQuery, w io.Writer) (*common.HttpMetadata, error) {
+	return c.DownloadCVSPaymentsReportWithContext(context.Background(), query, w)
+}
+
+func (c *Client) DownloadCVSPaymentsReportWithContext(ctx context.Context, query common.DateRangeQuery, w io.Writer, opts ...client.RequestOption) (*common.HttpMetadata, error) {
+	response, err := c.StreamCVSPaymentsReportWithContext(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = response.Save(w); err != nil {
+		return nil, err
+	}
+
+	return &response.HttpMetadata, nil
+}
+
+func (c *Client) StreamCVSSingleStatementReport(statementId string) (*common.StreamResponse, error) {
+	return c.StreamCVSSingleStatementReportWithContext(context.Background(), statementId)
+}
+
+func (c *Client) StreamCVSSingleStatementReportWithContext(ctx context.Context, statementId string, opts ...client.RequestOption) (*common.StreamResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var response common.StreamResponse
+	err = c.apiClient.GetWithContext(ctx, common.BuildPath(reporting, statements, statementId, payments, download), auth, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) DownloadCVSSingleStatementReport(statementId string, w io.Writer) (*common.HttpMetadata, error) {
+	return c.DownloadCVSSingleStatementReportWithContext(context.Background(), statementId, w)
+}
+
+func (c *Client) DownloadCVSSingleStatementReportWithContext(ctx context.Context, statementId string, w io.Writer, opts ...client.RequestOption) (*common.HttpMetadata, error) {
+	response, err := c.StreamCVSSingleStatementReportWithContext(ctx, statementId, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = response.Save(w); err != nil {
+		return nil, err
+	}
+
+	return &response.HttpMetadata, nil
+}
+
+func (c *Client) StreamCVSStatementsReport(query common.DateRangeQuery) (*common.StreamResponse, error) {
+	return c.StreamCVSStatementsReportWithContext(context.Background(), query)
+}
+
+func (c *Client) StreamCVSStatementsReportWithContext(ctx context.Context, query common.DateRangeQuery, opts ...client.RequestOption) (*common.StreamResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := common.BuildQueryPath(common.BuildPath(reporting, statements, download), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var response common.StreamResponse
+	err = c.apiClient.GetWithContext(ctx, url, auth, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) DownloadCVSStatementsReport(query common.DateRangeQuery, w io.Writer) (*common.HttpMetadata, error) {
+	return c.DownloadCVSStatementsReportWithContext(context.Background(), query, w)
+}
+
+func (c *Client) DownloadCVSStatementsReportWithContext(ctx context.Context, query common.DateRangeQuery, w io.Writer, opts ...client.RequestOption) (*common.HttpMetadata, error) {
+	response, err := c.StreamCVSStatementsReportWithContext(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = response.Save(w); err != nil {
+		return nil, err
+	}
+
+	return &response.HttpMetadata, nil
+}
@@ -1,7 +1,10 @@
 package reconciliation
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -561,3 +564,204 @@ func TestRetrieveCVSStatementsReport(t *testing.T) {
 		})
 	}
 }
+
+func TestDownloadCVSPaymentsReport(t *testing.T) {
+	cases := []struct {
+		name             string
+		getAuthorization func(*mock.Mock) mock.Call
+		apiGet           func(*mock.Mock) mock.Call
+		checker          func(*common.HttpMetadata, string, error)
+	}{
+		{
+			name: "when report exists then write report content",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(3).(*common.StreamResponse)
+						respMapping.HttpMetadata = mocks.HttpMetadataStatusOk
+						respMapping.Body = ioutil.NopCloser(strings.NewReader("content"))
+					})
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, http.StatusOK, metadata.StatusCode)
+				assert.Equal(t, "content", content)
+			},
+		},
+		{
+			name: "when credentials invalid then return error",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(nil, errors.CheckoutAuthorizationError("Invalid authorization type"))
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, metadata)
+				assert.Empty(t, content)
+				chkErr := err.(errors.CheckoutAuthorizationError)
+				assert.Equal(t, "Invalid authorization type", chkErr.Error())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiGet(&apiClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient)
+
+			var content bytes.Buffer
+			metadata, err := client.DownloadCVSPaymentsReport(common.DateRangeQuery{}, &content)
+			tc.checker(metadata, content.String(), err)
+		})
+	}
+}
+
+func TestDownloadCVSSingleStatementReport(t *testing.T) {
+	cases := []struct {
+		name             string
+		getAuthorization func(*mock.Mock) mock.Call
+		apiGet           func(*mock.Mock) mock.Call
+		checker          func(*common.HttpMetadata, string, error)
+	}{
+		{
+			name: "when report exists then write report content",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(3).(*common.StreamResponse)
+						respMapping.HttpMetadata = mocks.HttpMetadataStatusOk
+						respMapping.Body = ioutil.NopCloser(strings.NewReader("content"))
+					})
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, http.StatusOK, metadata.StatusCode)
+				assert.Equal(t, "content", content)
+			},
+		},
+		{
+			name: "when credentials invalid then return error",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(nil, errors.CheckoutAuthorizationError("Invalid authorization type"))
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, metadata)
+				assert.Empty(t, content)
+				chkErr := err.(errors.CheckoutAuthorizationError)
+				assert.Equal(t, "Invalid authorization type", chkErr.Error())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiGet(&apiClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient)
+
+			var content bytes.Buffer
+			metadata, err := client.DownloadCVSSingleStatementReport("stm_123", &content)
+			tc.checker(metadata, content.String(), err)
+		})
+	}
+}
+
+func TestDownloadCVSStatementsReport(t *testing.T) {
+	cases := []struct {
+		name             string
+		getAuthorization func(*mock.Mock) mock.Call
+		apiGet           func(*mock.Mock) mock.Call
+		checker          func(*common.HttpMetadata, string, error)
+	}{
+		{
+			name: "when report exists then write report content",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(3).(*common.StreamResponse)
+						respMapping.HttpMetadata = mocks.HttpMetadataStatusOk
+						respMapping.Body = ioutil.NopCloser(strings.NewReader("content"))
+					})
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, http.StatusOK, metadata.StatusCode)
+				assert.Equal(t, "content", content)
+			},
+		},
+		{
+			name: "when credentials invalid then return error",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(nil, errors.CheckoutAuthorizationError("Invalid authorization type"))
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, metadata)
+				assert.Empty(t, content)
+				chkErr := err.(errors.CheckoutAuthorizationError)
+				assert.Equal(t, "Invalid authorization type", chkErr.Error())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiGet(&apiClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient)
+
+			var content bytes.Buffer
+			metadata, err := client.DownloadCVSStatementsReport(common.DateRangeQuery{}, &content)
+			tc.checker(metadata, content.String(), err)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package recorder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+const base64Encoding = "base64"
+
+type (
+	// Cassette holds the interactions recorded for a test, in the order they happened
+	Cassette struct {
+		Interactions []Interaction `json:"interactions"`
+	}
+
+	Interaction struct {
+		Request  Request  `json:"request"`
+		Response Response `json:"response"`
+	}
+
+	Request struct {
+		Method         string      `json:"method"`
+		Path           string      `json:"path"`
+		IdempotencyKey string      `json:"idempotency_key,omitempty"`
+		Header         http.Header `json:"header,omitempty"`
+		Body           Body        `json:"body,omitempty"`
+	}
+
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       Body        `json:"body,omitempty"`
+	}
+
+	// Body is stored as text when it is valid UTF-8 and base64 encoded otherwise, so that binary report
+	// files survive a round trip through the cassette
+	Body []byte
+)
+
+type encodedBody struct {
+	Encoding string `json:"encoding"`
+	Data     string `json:"data"`
+}
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(encodedBody{Encoding: base64Encoding, Data: base64.StdEncoding.EncodeToString(b)})
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*b = Body(text)
+		return nil
+	}
+
+	var encoded encodedBody
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Data)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err = json.Unmarshal(data, &cassette); err != nil {
+		return nil, err
+	}
+	return &cassette, nil
+}
+
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,219 @@
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+)
+
+type Mode int
+
+const (
+	// Record sends every request to the API and keeps the redacted interaction in the cassette
+	Record Mode = iota
+	// Replay serves the interactions of the cassette without any network access
+	Replay
+)
+
+const (
+	idempotencyKeyHeader = "Cko-Idempotency-Key"
+	boundaryPlaceholder  = "recorder-boundary"
+)
+
+var ErrInteractionNotFound = errors.New("recorder: no recorded interaction matches the request")
+
+// Recorder is an http.RoundTripper that records the calls of the SDK into a cassette file, or replays them from it.
+// Requests are matched on method, path, body and idempotency key. Keys generated by the SDK, which change on every
+// run, are not recorded and not matched. Every recorded interaction is replayed once.
+type Recorder struct {
+	// Transport sends the requests in Record mode. It defaults to http.DefaultTransport
+	Transport http.RoundTripper
+	// Redactor removes secrets and card numbers before an interaction is written. It defaults to common.DefaultRedactor
+	Redactor *common.Redactor
+
+	mode     Mode
+	path     string
+	mutex    sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// NewRecorder creates a recorder backed by the cassette file at path. In Replay mode the file must exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		Redactor: common.DefaultRedactor(),
+		mode:     mode,
+		path:     path,
+		cassette: &Cassette{},
+	}
+
+	if mode == Replay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.replayed = make([]bool, len(cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Client returns an HTTP client using the recorder, to be passed to the WithHttpClient option of the SDK builders.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := r.recordRequest(req, body)
+
+	if r.mode == Replay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+// Save writes the interactions recorded so far to the cassette file. It does nothing in Replay mode.
+func (r *Recorder) Save() error {
+	if r.mode == Replay {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.mutex.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.redactor().RedactHeaders(resp.Header),
+			Body:       r.redactBody(req.URL.Path, resp.Header.Get("Content-Type"), body),
+		},
+	})
+	r.mutex.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.replayed[i] = true
+
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, recorded.Method, recorded.Path)
+}
+
+func (r *Recorder) recordRequest(req *http.Request, body []byte) Request {
+	header := r.redactor().RedactHeaders(req.Header)
+	idempotencyKey := req.Header.Get(idempotencyKeyHeader)
+	if configuration.IsIdempotencyKeyGenerated(req.Context()) {
+		idempotencyKey = ""
+		header.Del(idempotencyKeyHeader)
+	}
+
+	return Request{
+		Method:         req.Method,
+		Path:           req.URL.RequestURI(),
+		IdempotencyKey: idempotencyKey,
+		Header:         header,
+		Body:           r.redactBody(req.URL.Path, req.Header.Get("Content-Type"), body),
+	}
+}
+
+// redactBody removes the sensitive values of a body. The random boundary of multipart bodies is replaced by a fixed
+// placeholder so that uploads can be matched on replay, and only their card numbers are masked.
+func (r *Recorder) redactBody(path string, contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		body = bytes.Replace(body, []byte(params["boundary"]), []byte(boundaryPlaceholder), -1)
+		return []byte(r.redactor().RedactText(string(body)))
+	}
+	return r.redactor().RedactPathBody(path, body)
+}
+
+func (r *Recorder) redactor() *common.Redactor {
+	if r.Redactor == nil {
+		return common.DefaultRedactor()
+	}
+	return r.Redactor
+}
+
+func matches(recorded Request, actual Request) bool {
+	return recorded.Method == actual.Method &&
+		recorded.Path == actual.Path &&
+		recorded.IdempotencyKey == actual.IdempotencyKey &&
+		bytes.Equal(recorded.Body, actual.Body)
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
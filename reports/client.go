@@ -2,6 +2,7 @@ package reports
 
 import (
 	"context"
+	"io"
 
 	"github.com/checkout/checkout-sdk-go/v2/client"
 	"github.com/checkout/checkout-sdk-go/v2/common"
@@ -24,8 +25,9 @@ func (c *Client) GetAllReports(query QueryFilter) (*QueryResponse, error) {
 	return c.GetAllReportsWithContext(context.Background(), query)
 }
 
-func (c *Client) GetAllReportsWithContext(ctx context.Context, query QueryFilter) (*QueryResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetAllReportsWithContext(ctx context.Context, query QueryFilter, opts ...client.RequestOption) (*QueryResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +50,9 @@ func (c *Client) GetReportDetails(reportId string) (*ReportResponse, error) {
 	return c.GetReportDetailsWithContext(context.Background(), reportId)
 }
 
-func (c *Client) GetReportDetailsWithContext(ctx context.Context, reportId string) (*ReportResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetReportDetailsWithContext(ctx context.Context, reportId string, opts ...client.RequestOption) (*ReportResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +70,9 @@ func (c *Client) GetReportFile(reportId, fileId string) (*common.ContentResponse
 	return c.GetReportFileWithContext(context.Background(), reportId, fileId)
 }
 
-func (c *Client) GetReportFileWithContext(ctx context.Context, reportId, fileId string) (*common.ContentResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetReportFileWithContext(ctx context.Context, reportId, fileId string, opts ...client.RequestOption) (*common.ContentResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -81,3 +85,40 @@ func (c *Client) GetReportFileWithContext(ctx context.Context, reportId, fileId
 
 	return &response, nil
 }
+
+func (c *Client) StreamReportFile(reportId, fileId string) (*common.StreamResponse, error) {
+	return c.StreamReportFileWithContext(context.Background(), reportId, fileId)
+}
+
+func (c *Client) StreamReportFileWithContext(ctx context.Context, reportId, fileId string, opts ...client.RequestOption) (*common.StreamResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
+	if err != nil {
+		return nil, err
+	}
+
+	var response common.StreamResponse
+	err = c.apiClient.GetWithContext(ctx, common.BuildPath(reports, reportId, files, fileId), auth, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) DownloadReportFile(reportId, fileId string, w io.Writer) (*common.HttpMetadata, error) {
+	return c.DownloadReportFileWithContext(context.Background(), reportId, fileId, w)
+}
+
+func (c *Client) DownloadReportFileWithContext(ctx context.Context, reportId, fileId string, w io.Writer, opts ...client.RequestOption) (*common.HttpMetadata, error) {
+	response, err := c.StreamReportFileWithContext(ctx, reportId, fileId, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = response.Save(w); err != nil {
+		return nil, err
+	}
+
+	return &response.HttpMetadata, nil
+}
@@ -1,12 +1,17 @@
 package reports
 
 import (
+	"bytes"
+	"context"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/checkout/checkout-sdk-go/v2/client"
 	"github.com/checkout/checkout-sdk-go/v2/common"
 	"github.com/checkout/checkout-sdk-go/v2/configuration"
 	"github.com/checkout/checkout-sdk-go/v2/errors"
@@ -313,3 +318,103 @@ func TestGetReportFile(t *testing.T) {
 		})
 	}
 }
+
+func TestDownloadReportFile(t *testing.T) {
+	cases := []struct {
+		name             string
+		getAuthorization func(*mock.Mock) mock.Call
+		apiGet           func(*mock.Mock) mock.Call
+		checker          func(*common.HttpMetadata, string, error)
+	}{
+		{
+			name: "when file exists then write file content",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, "/reports/rpt_123/files/file_456", mock.Anything, mock.Anything).
+					Return(nil).
+					Run(func(args mock.Arguments) {
+						respMapping := args.Get(3).(*common.StreamResponse)
+						respMapping.HttpMetadata = mocks.HttpMetadataStatusOk
+						respMapping.Body = ioutil.NopCloser(strings.NewReader("header1,header2\nvalue1,value2"))
+					})
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, http.StatusOK, metadata.StatusCode)
+				assert.Equal(t, "header1,header2\nvalue1,value2", content)
+			},
+		},
+		{
+			name: "when file not found then return error",
+			getAuthorization: func(m *mock.Mock) mock.Call {
+				return *m.On("GetAuthorization", mock.Anything).
+					Return(&configuration.SdkAuthorization{}, nil)
+			},
+			apiGet: func(m *mock.Mock) mock.Call {
+				return *m.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(
+						errors.CheckoutAPIError{
+							StatusCode: http.StatusNotFound,
+							Status:     "404 Not Found",
+						})
+			},
+			checker: func(metadata *common.HttpMetadata, content string, err error) {
+				assert.Nil(t, metadata)
+				assert.Empty(t, content)
+				chkErr := err.(errors.CheckoutAPIError)
+				assert.Equal(t, http.StatusNotFound, chkErr.StatusCode)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiClient := new(mocks.ApiClientMock)
+			credentials := new(mocks.CredentialsMock)
+			environment := new(mocks.EnvironmentMock)
+			enableTelemetry := true
+
+			tc.getAuthorization(&credentials.Mock)
+			tc.apiGet(&apiClient.Mock)
+
+			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+			client := NewClient(config, apiClient)
+
+			var content bytes.Buffer
+			metadata, err := client.DownloadReportFile("rpt_123", "file_456", &content)
+			tc.checker(metadata, content.String(), err)
+		})
+	}
+}
+
+func TestGetReportDetailsWithOptions(t *testing.T) {
+	apiClient := new(mocks.ApiClientMock)
+	credentials := new(mocks.CredentialsMock)
+	overrideCredentials := new(mocks.CredentialsMock)
+	environment := new(mocks.EnvironmentMock)
+	enableTelemetry := true
+	overrideAuth := &configuration.SdkAuthorization{PlatformType: configuration.Default, Credential: "sk_override"}
+
+	overrideCredentials.On("GetAuthorization", configuration.SecretKeyOrOauth).Return(overrideAuth, nil)
+	apiClient.On("GetWithContext", mock.Anything, "/reports/rpt_123", overrideAuth, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			options := client.RequestOptionsFromContext(args.Get(0).(context.Context))
+			assert.Equal(t, "corr-123", options.Headers.Get("X-Correlation-Id"))
+		})
+
+	config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
+	reportsClient := NewClient(config, apiClient)
+
+	response, err := reportsClient.GetReportDetailsWithContext(context.Background(), "rpt_123",
+		client.WithCredentials(overrideCredentials),
+		client.WithHeader("X-Correlation-Id", "corr-123"))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+	credentials.AssertNotCalled(t, "GetAuthorization", mock.Anything)
+	apiClient.AssertExpectations(t)
+}
@@ -0,0 +1,30 @@
+//go:build go1.23
+
+package reports
+
+import (
+	"context"
+	"iter"
+
+	"github.com/checkout/checkout-sdk-go/v2/client"
+	"github.com/checkout/checkout-sdk-go/v2/common"
+)
+
+// ReportsPager pages through the reports matching the query, starting at query.PaginationToken.
+func (c *Client) ReportsPager(query QueryFilter, opts ...client.RequestOption) *common.Pager[ReportResponse] {
+	return common.NewPager(func(ctx context.Context, cursor string) (common.Page[ReportResponse], error) {
+		pageQuery := query
+		if cursor != "" {
+			pageQuery.PaginationToken = cursor
+		}
+		response, err := c.GetAllReportsWithContext(ctx, pageQuery, opts...)
+		if err != nil {
+			return common.Page[ReportResponse]{}, err
+		}
+		return common.Page[ReportResponse]{Items: response.Data, Next: common.NextPaginationToken(response.Links)}, nil
+	})
+}
+
+func (c *Client) AllReports(ctx context.Context, query QueryFilter, opts ...client.RequestOption) iter.Seq2[ReportResponse, error] {
+	return c.ReportsPager(query, opts...).All(ctx)
+}
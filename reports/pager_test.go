@@ -0,0 +1,51 @@
+//go:build go1.23
+
+package reports
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/checkout/checkout-sdk-go/v2/common"
+	"github.com/checkout/checkout-sdk-go/v2/configuration"
+	"github.com/checkout/checkout-sdk-go/v2/mocks"
+)
+
+func TestAllReports(t *testing.T) {
+	next := "https://api.checkout.com/reports?limit=2&pagination_token=token_2"
+	pages := map[string]QueryResponse{
+		"/reports?entity_id=ent_test&limit=2": {
+			Data:  []ReportResponse{{Id: "rpt_1"}, {Id: "rpt_2"}},
+			Links: map[string]common.Link{"next": {HRef: &next}},
+		},
+		"/reports?entity_id=ent_test&limit=2&pagination_token=token_2": {
+			Data: []ReportResponse{{Id: "rpt_3"}},
+		},
+	}
+
+	apiClient := new(mocks.ApiClientMock)
+	credentials := new(mocks.CredentialsMock)
+	credentials.On("GetAuthorization", mock.Anything).Return(&configuration.SdkAuthorization{}, nil)
+	apiClient.On("GetWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			*args.Get(3).(*QueryResponse) = pages[args.String(1)]
+		})
+
+	enableTelemetry := true
+	config := configuration.NewConfiguration(credentials, &enableTelemetry, new(mocks.EnvironmentMock), &http.Client{}, nil)
+	client := NewClient(config, apiClient)
+
+	var ids []string
+	for report, err := range client.AllReports(context.Background(), QueryFilter{EntityId: "ent_test", Limit: 2}) {
+		assert.Nil(t, err)
+		ids = append(ids, report.Id)
+	}
+
+	assert.Equal(t, []string{"rpt_1", "rpt_2", "rpt_3"}, ids)
+	apiClient.AssertNumberOfCalls(t, "GetWithContext", 2)
+}
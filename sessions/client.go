@@ -31,8 +31,10 @@ func (c *Client) RequestSession(request SessionRequest) (*SessionResponse, error
 func (c *Client) RequestSessionWithContext(
 	ctx context.Context,
 	request SessionRequest,
+	opts ...client.RequestOption,
 ) (*SessionResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +65,10 @@ func (c *Client) GetSessionDetailsWithContext(
 	ctx context.Context,
 	sessionId string,
 	sessionSecret string,
+	opts ...client.RequestOption,
 ) (*GetSessionResponse, error) {
-	auth, err := c.customSdkAuthorization(sessionSecret)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := c.customSdkAuthorization(ctx, sessionSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +100,10 @@ func (c *Client) UpdateSessionWithContext(
 	sessionId string,
 	request channels.Channel,
 	sessionSecret string,
+	opts ...client.RequestOption,
 ) (*GetSessionResponse, error) {
-	auth, err := c.customSdkAuthorization(sessionSecret)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := c.customSdkAuthorization(ctx, sessionSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -126,8 +132,10 @@ func (c *Client) CompleteSessionWithContext(
 	ctx context.Context,
 	sessionId string,
 	sessionSecret string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.customSdkAuthorization(sessionSecret)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := c.customSdkAuthorization(ctx, sessionSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +169,10 @@ func (c *Client) Update3dsMethodCompletionWithContext(
 	sessionId string,
 	request ThreeDsMethodCompletionRequest,
 	sessionSecret string,
+	opts ...client.RequestOption,
 ) (*Update3dsMethodCompletionResponse, error) {
-	auth, err := c.customSdkAuthorization(sessionSecret)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := c.customSdkAuthorization(ctx, sessionSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +193,9 @@ func (c *Client) Update3dsMethodCompletionWithContext(
 	return &response, nil
 }
 
-func (c *Client) customSdkAuthorization(sessionSecret string) (*configuration.SdkAuthorization, error) {
+func (c *Client) customSdkAuthorization(ctx context.Context, sessionSecret string) (*configuration.SdkAuthorization, error) {
 	if sessionSecret == "" {
-		return c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+		return client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	}
 	return NewSessionSecretCredentials(sessionSecret).GetAuthorization(configuration.CustomAuth)
 }
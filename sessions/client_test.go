@@ -1,6 +1,7 @@
 package sessions
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -615,7 +616,7 @@ func TestCustomSdkAuthorization(t *testing.T) {
 			config := configuration.NewConfiguration(credentials, &enableTelemetry, environment, &http.Client{}, nil)
 			client := NewClient(config, apiClient)
 
-			tc.checker(client.customSdkAuthorization(tc.sessionSecret))
+			tc.checker(client.customSdkAuthorization(context.Background(), tc.sessionSecret))
 		})
 	}
 }
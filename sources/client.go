@@ -23,8 +23,9 @@ func (c *Client) CreateSepaSource(request *sepaSourceRequest) (*CreateSepaSource
 	return c.CreateSepaSourceWithContext(context.Background(), request)
 }
 
-func (c *Client) CreateSepaSourceWithContext(ctx context.Context, request *sepaSourceRequest) (*CreateSepaSourceResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) CreateSepaSourceWithContext(ctx context.Context, request *sepaSourceRequest, opts ...client.RequestOption) (*CreateSepaSourceResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
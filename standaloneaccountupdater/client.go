@@ -24,8 +24,9 @@ func (c *Client) GetUpdatedCardCredentials(request GetUpdatedCardCredentialsRequ
 	return c.GetUpdatedCardCredentialsWithContext(context.Background(), request)
 }
 
-func (c *Client) GetUpdatedCardCredentialsWithContext(ctx context.Context, request GetUpdatedCardCredentialsRequest) (*GetUpdatedCardCredentialsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.OAuth)
+func (c *Client) GetUpdatedCardCredentialsWithContext(ctx context.Context, request GetUpdatedCardCredentialsRequest, opts ...client.RequestOption) (*GetUpdatedCardCredentialsResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.OAuth)
 	if err != nil {
 		return nil, err
 	}
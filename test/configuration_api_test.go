@@ -81,4 +81,7 @@ func TestShouldCreateConfigurationWithSubdomainForProduction(t *testing.T) {
 	assert.NotNil(t, config)
 	assert.Equal(t, "https://1234prod.api.checkout.com", config.EnvironmentSubdomain.ApiUrl)
 	assert.Equal(t, "https://1234prod.access.checkout.com/connect/token", config.EnvironmentSubdomain.AuthorizationUrl)
+	assert.Equal(t, "https://1234prod.files.checkout.com/", config.EnvironmentSubdomain.FilesUrl)
+	assert.Equal(t, "https://1234prod.transfers.checkout.com/", config.EnvironmentSubdomain.TransfersUrl)
+	assert.Equal(t, "https://1234prod.balances.checkout.com/", config.EnvironmentSubdomain.BalancesUrl)
 }
@@ -23,8 +23,9 @@ func (c *Client) RequestCardToken(request CardTokenRequest) (*CardTokenResponse,
 	return c.RequestCardTokenWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestCardTokenWithContext(ctx context.Context, request CardTokenRequest) (*CardTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) RequestCardTokenWithContext(ctx context.Context, request CardTokenRequest, opts ...client.RequestOption) (*CardTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -49,8 +50,9 @@ func (c *Client) RequestWalletToken(request WalletTokenRequest) (*CardTokenRespo
 	return c.RequestWalletTokenWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestWalletTokenWithContext(ctx context.Context, request WalletTokenRequest) (*CardTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) RequestWalletTokenWithContext(ctx context.Context, request WalletTokenRequest, opts ...client.RequestOption) (*CardTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +77,9 @@ func (c *Client) RequestCvvToken(request CvvTokenRequest) (*CvvTokenResponse, er
 	return c.RequestCvvTokenWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestCvvTokenWithContext(ctx context.Context, request CvvTokenRequest) (*CvvTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) RequestCvvTokenWithContext(ctx context.Context, request CvvTokenRequest, opts ...client.RequestOption) (*CvvTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +104,9 @@ func (c *Client) RequestPinToken(request PinTokenRequest) (*PinTokenResponse, er
 	return c.RequestPinTokenWithContext(context.Background(), request)
 }
 
-func (c *Client) RequestPinTokenWithContext(ctx context.Context, request PinTokenRequest) (*PinTokenResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.PublicKey)
+func (c *Client) RequestPinTokenWithContext(ctx context.Context, request PinTokenRequest, opts ...client.RequestOption) (*PinTokenResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -127,8 +131,9 @@ func (c *Client) GetTokenMetadata(tokenId string) (*TokenMetadataResponse, error
 	return c.GetTokenMetadataWithContext(context.Background(), tokenId)
 }
 
-func (c *Client) GetTokenMetadataWithContext(ctx context.Context, tokenId string) (*TokenMetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetTokenMetadataWithContext(ctx context.Context, tokenId string, opts ...client.RequestOption) (*TokenMetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
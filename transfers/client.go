@@ -36,8 +36,9 @@ func (c *Client) InitiateTransferOfFoundsWithContext(
 	ctx context.Context,
 	request TransferRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*TransferResponse, error) {
-	return c.InitiateTransferOfFundsWithContext(ctx, request, idempotencyKey)
+	return c.InitiateTransferOfFundsWithContext(ctx, request, idempotencyKey, opts...)
 }
 
 func (c *Client) InitiateTransferOfFunds(
@@ -51,8 +52,10 @@ func (c *Client) InitiateTransferOfFundsWithContext(
 	ctx context.Context,
 	request TransferRequest,
 	idempotencyKey *string,
+	opts ...client.RequestOption,
 ) (*TransferResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +83,10 @@ func (c *Client) RetrieveTransfer(transferId string) (*TransferDetails, error) {
 func (c *Client) RetrieveTransferWithContext(
 	ctx context.Context,
 	transferId string,
+	opts ...client.RequestOption,
 ) (*TransferDetails, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -23,8 +23,9 @@ func (c *Client) RetrieveWebhooks() (*WebhooksResponse, error) {
 	return c.RetrieveWebhooksWithContext(context.Background())
 }
 
-func (c *Client) RetrieveWebhooksWithContext(ctx context.Context) (*WebhooksResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveWebhooksWithContext(ctx context.Context, opts ...client.RequestOption) (*WebhooksResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +48,9 @@ func (c *Client) RegisterWebhook(request WebhookRequest) (*WebhookResponse, erro
 	return c.RegisterWebhookWithContext(context.Background(), request)
 }
 
-func (c *Client) RegisterWebhookWithContext(ctx context.Context, request WebhookRequest) (*WebhookResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RegisterWebhookWithContext(ctx context.Context, request WebhookRequest, opts ...client.RequestOption) (*WebhookResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +75,9 @@ func (c *Client) RetrieveWebhook(webhookId string) (*WebhookResponse, error) {
 	return c.RetrieveWebhookWithContext(context.Background(), webhookId)
 }
 
-func (c *Client) RetrieveWebhookWithContext(ctx context.Context, webhookId string) (*WebhookResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RetrieveWebhookWithContext(ctx context.Context, webhookId string, opts ...client.RequestOption) (*WebhookResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +100,9 @@ func (c *Client) UpdateWebhook(webhookId string, request WebhookRequest) (*Webho
 	return c.UpdateWebhookWithContext(context.Background(), webhookId, request)
 }
 
-func (c *Client) UpdateWebhookWithContext(ctx context.Context, webhookId string, request WebhookRequest) (*WebhookResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) UpdateWebhookWithContext(ctx context.Context, webhookId string, request WebhookRequest, opts ...client.RequestOption) (*WebhookResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -123,8 +127,9 @@ func (c *Client) PartiallyUpdateWebhook(webhookId string, request WebhookRequest
 	return c.PartiallyUpdateWebhookWithContext(context.Background(), webhookId, request)
 }
 
-func (c *Client) PartiallyUpdateWebhookWithContext(ctx context.Context, webhookId string, request WebhookRequest) (*WebhookResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) PartiallyUpdateWebhookWithContext(ctx context.Context, webhookId string, request WebhookRequest, opts ...client.RequestOption) (*WebhookResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +153,9 @@ func (c *Client) RemoveWebhook(webhookId string) (*common.MetadataResponse, erro
 	return c.RemoveWebhookWithContext(context.Background(), webhookId)
 }
 
-func (c *Client) RemoveWebhookWithContext(ctx context.Context, webhookId string) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKey)
+func (c *Client) RemoveWebhookWithContext(ctx context.Context, webhookId string, opts ...client.RequestOption) (*common.MetadataResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKey)
 	if err != nil {
 		return nil, err
 	}
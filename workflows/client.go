@@ -30,8 +30,10 @@ func (c *Client) GetWorkflows() (*GetWorkflowsResponse, error) {
 
 func (c *Client) GetWorkflowsWithContext(
 	ctx context.Context,
+	opts ...client.RequestOption,
 ) (*GetWorkflowsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +59,10 @@ func (c *Client) CreateWorkflow(request CreateWorkflowRequest) (*common.IdRespon
 func (c *Client) CreateWorkflowWithContext(
 	ctx context.Context,
 	request CreateWorkflowRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -86,8 +90,10 @@ func (c *Client) GetWorkflow(workflowId string) (*GetWorkflowResponse, error) {
 func (c *Client) GetWorkflowWithContext(
 	ctx context.Context,
 	workflowId string,
+	opts ...client.RequestOption,
 ) (*GetWorkflowResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -113,8 +119,10 @@ func (c *Client) RemoveWorkflow(workflowId string) (*common.MetadataResponse, er
 func (c *Client) RemoveWorkflowWithContext(
 	ctx context.Context,
 	workflowId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +152,10 @@ func (c *Client) UpdateWorkflowWithContext(
 	ctx context.Context,
 	workflowId string,
 	request UpdateWorkflowRequest,
+	opts ...client.RequestOption,
 ) (*UpdateWorkflowResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -176,8 +186,10 @@ func (c *Client) AddWorkflowActionWithContext(
 	ctx context.Context,
 	workflowId string,
 	request actions.ActionsRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -211,8 +223,10 @@ func (c *Client) UpdateWorkflowActionWithContext(
 	workflowId string,
 	actionId string,
 	request actions.ActionsRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +255,10 @@ func (c *Client) RemoveWorkflowActionWithContext(
 	ctx context.Context,
 	workflowId string,
 	actionId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -270,8 +286,10 @@ func (c *Client) AddWorkflowConditionWithContext(
 	ctx context.Context,
 	workflowId string,
 	request conditions.ConditionsRequest,
+	opts ...client.RequestOption,
 ) (*common.IdResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -303,8 +321,10 @@ func (c *Client) UpdateWorkflowConditionWithContext(
 	workflowId string,
 	conditionId string,
 	request conditions.ConditionsRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -331,8 +351,10 @@ func (c *Client) RemoveWorkflowConditionWithContext(
 	ctx context.Context,
 	workflowId string,
 	conditionId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -357,8 +379,10 @@ func (c *Client) TestWorkflowWithContext(
 	ctx context.Context,
 	workflowId string,
 	request events.EventTypesRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -381,8 +405,9 @@ func (c *Client) GetEventTypes() (*events.EventTypesResponse, error) {
 	return c.GetEventTypesWithContext(context.Background())
 }
 
-func (c *Client) GetEventTypesWithContext(ctx context.Context) (*events.EventTypesResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+func (c *Client) GetEventTypesWithContext(ctx context.Context, opts ...client.RequestOption) (*events.EventTypesResponse, error) {
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -406,8 +431,10 @@ func (c *Client) GetEvent(eventId string) (*events.EventResponse, error) {
 func (c *Client) GetEventWithContext(
 	ctx context.Context,
 	eventId string,
+	opts ...client.RequestOption,
 ) (*events.EventResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +459,10 @@ func (c *Client) GetActionInvocationsWithContext(
 	ctx context.Context,
 	eventId string,
 	actionId string,
+	opts ...client.RequestOption,
 ) (*actions.ActionInvocationsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -457,8 +486,10 @@ func (c *Client) ReflowByEvent(eventId string) (*common.MetadataResponse, error)
 func (c *Client) ReflowByEventWithContext(
 	ctx context.Context,
 	eventId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -485,8 +516,10 @@ func (c *Client) ReflowByEventAndWorkflowWithContext(
 	ctx context.Context,
 	eventId string,
 	workflowId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -512,8 +545,10 @@ func (c *Client) Reflow(request reflows.ReflowRequest) (*common.MetadataResponse
 func (c *Client) ReflowWithContext(
 	ctx context.Context,
 	request reflows.ReflowRequest,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +574,10 @@ func (c *Client) GetSubjectEvents(subjectId string) (*events.SubjectEventsRespon
 func (c *Client) GetSubjectEventsWithContext(
 	ctx context.Context,
 	subjectId string,
+	opts ...client.RequestOption,
 ) (*events.SubjectEventsResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +601,10 @@ func (c *Client) ReflowBySubject(subjectId string) (*common.MetadataResponse, er
 func (c *Client) ReflowBySubjectWithContext(
 	ctx context.Context,
 	subjectId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}
@@ -592,8 +631,10 @@ func (c *Client) ReflowBySubjectAndWorkflowWithContext(
 	ctx context.Context,
 	subjectId string,
 	workflowId string,
+	opts ...client.RequestOption,
 ) (*common.MetadataResponse, error) {
-	auth, err := c.configuration.Credentials.GetAuthorization(configuration.SecretKeyOrOauth)
+	ctx = client.WithRequestOptions(ctx, opts...)
+	auth, err := client.RequestCredentials(ctx, c.configuration.Credentials).GetAuthorization(configuration.SecretKeyOrOauth)
 	if err != nil {
 		return nil, err
 	}